@@ -11,6 +11,15 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cast"
+	"github.com/unicorn-research/chain/x/articles"
+	articleskeeper "github.com/unicorn-research/chain/x/articles/keeper"
+	articlestypes "github.com/unicorn-research/chain/x/articles/types"
+	"github.com/unicorn-research/chain/x/dissolution"
+	dissolutionkeeper "github.com/unicorn-research/chain/x/dissolution/keeper"
+	dissolutiontypes "github.com/unicorn-research/chain/x/dissolution/types"
+	"github.com/unicorn-research/chain/x/membership"
+	membershipkeeper "github.com/unicorn-research/chain/x/membership/keeper"
+	membershiptypes "github.com/unicorn-research/chain/x/membership/types"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
@@ -139,6 +148,7 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		icatypes.ModuleName:            nil,
+		membershiptypes.ModuleName:     nil,
 	}
 )
 
@@ -182,6 +192,11 @@ type SimApp struct {
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 	CircuitKeeper         circuitkeeper.Keeper
 
+	// DAO keepers
+	ArticlesKeeper    articleskeeper.Keeper
+	DissolutionKeeper dissolutionkeeper.Keeper
+	MembershipKeeper  membershipkeeper.Keeper
+
 	// the module manager
 	ModuleManager      *module.Manager
 	BasicModuleManager module.BasicManager
@@ -267,6 +282,7 @@ func NewSimApp(
 		govtypes.StoreKey, group.StoreKey, paramstypes.StoreKey, ibcexported.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, icacontrollertypes.StoreKey, icahosttypes.StoreKey,
 		authzkeeper.StoreKey, consensusparamtypes.StoreKey, circuittypes.StoreKey,
+		articlestypes.StoreKey, dissolutiontypes.StoreKey, membershiptypes.StoreKey,
 	)
 
 	// register streaming services
@@ -358,16 +374,44 @@ func NewSimApp(
 		Example of setting gov params:
 		govConfig.MaxMetadataLen = 10000
 	*/
+	// the membership keeper tallies the proposals, by membership interest
+	// when its params say so and by stake otherwise
+	app.MembershipKeeper = membershipkeeper.NewKeeper(
+		appCodec, runtime.NewKVStoreService(keys[membershiptypes.StoreKey]), app.AccountKeeper,
+		app.BankKeeper, app.StakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	govKeeper := govkeeper.NewKeeper(
 		appCodec, runtime.NewKVStoreService(keys[govtypes.StoreKey]), app.AccountKeeper, app.BankKeeper,
 		app.StakingKeeper, app.DistrKeeper, app.MsgServiceRouter(), govConfig, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		govkeeper.WithCustomCalculateVoteResultsAndVotingPowerFn(app.MembershipKeeper.CalculateVoteResultsAndVotingPower),
+	)
+
+	// the dissolution watchdog reads the proposals whose voting period ended,
+	// and halts the chain through the circuit breaker when the DAO dissolves;
+	// a dissolution by member vote winds the chain down through the circuit
+	// breaker and distributes the community pool over the blocks until it halts
+	app.DissolutionKeeper = dissolutionkeeper.NewKeeper(
+		appCodec, runtime.NewKVStoreService(keys[dissolutiontypes.StoreKey]), app.AccountKeeper,
+		app.BankKeeper, app.StakingKeeper, app.DistrKeeper, app.UpgradeKeeper, app.AccountKeeper.Accounts,
+		app.DistrKeeper.FeePool, govKeeper.Proposals, app.CircuitKeeper.DisableList, interfaceRegistry,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	app.GovKeeper = *govKeeper.SetHooks(
 		govtypes.NewMultiGovHooks( // register the governance hooks
+			app.DissolutionKeeper.Hooks(),
 		),
 	)
 
+	// the articles of organization name every module account and message
+	// type, so the keeper reads them from the account keeper and the
+	// interface registry
+	app.ArticlesKeeper = articleskeeper.NewKeeper(
+		appCodec, runtime.NewKVStoreService(keys[articlestypes.StoreKey]), app.AccountKeeper,
+		interfaceRegistry, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// ICA Controller keeper
 	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
 		appCodec, runtime.NewKVStoreService(keys[icacontrollertypes.StoreKey]), app.GetSubspace(icacontrollertypes.SubModuleName),
@@ -490,6 +534,11 @@ func NewSimApp(
 		// IBC light clients
 		ibctm.NewAppModule(tmLightClientModule),
 		solomachine.NewAppModule(smLightClientModule),
+
+		// DAO modules
+		articles.NewAppModule(app.ArticlesKeeper),
+		dissolution.NewAppModule(app.DissolutionKeeper),
+		membership.NewAppModule(app.MembershipKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
@@ -534,6 +583,7 @@ func NewSimApp(
 	app.ModuleManager.SetOrderEndBlockers(
 		crisistypes.ModuleName,
 		govtypes.ModuleName,
+		dissolutiontypes.ModuleName,
 		stakingtypes.ModuleName,
 		ibcexported.ModuleName,
 		ibctransfertypes.ModuleName,
@@ -553,6 +603,7 @@ func NewSimApp(
 		ibcexported.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, ibctransfertypes.ModuleName,
 		icatypes.ModuleName, feegrant.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
 		vestingtypes.ModuleName, group.ModuleName, consensusparamtypes.ModuleName, circuittypes.ModuleName,
+		articlestypes.ModuleName, dissolutiontypes.ModuleName, membershiptypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -2,6 +2,8 @@ package cmd_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -23,3 +25,23 @@ func TestInitCmd(t *testing.T) {
 
 	require.NoError(t, svrcmd.Execute(rootCmd, "", simapp.DefaultNodeHome))
 }
+
+func TestMigrateSnapshotCmd(t *testing.T) {
+	t.Parallel()
+	home := t.TempDir()
+	rootCmd := cmd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"genesis",
+		"migrate-snapshot",
+		"../../QmNyt5bh6KRgPukeH2XScdRnycn4pxHVyAdMKgrHVMktGX", // The bundled snapshot
+		"--genesis-time=2025-01-01T00:00:00Z",
+		"--supply-policy=recompute", // The snapshot has no liquid balances to match its supply
+		fmt.Sprintf("--report-dir=%s", t.TempDir()),
+		fmt.Sprintf("--home=%s", home),
+	})
+
+	require.NoError(t, svrcmd.Execute(rootCmd, "", home))
+
+	_, err := os.Stat(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+}
@@ -0,0 +1,212 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/unicorn-research/chain/genesis-tool/migrate"
+	"github.com/unicorn-research/chain/params"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+)
+
+// Flags of the migrate-snapshot command, named as in genesis-tool.
+const (
+	flagManifest        = "manifest"
+	flagGenesisTime     = "genesis-time"
+	flagSupplyPolicy    = "supply-policy"
+	flagParkAddress     = "park-address"
+	flagModuleAccounts  = "module-accounts"
+	flagModuleRecipient = "module-account-recipient"
+	flagReportDir       = "report-dir"
+	flagStaking         = "staking"
+	flagValidatorKeys   = "validator-keys"
+	flagValidatorMap    = "validator-map"
+	flagUnbonding       = "unbonding"
+	flagMerge           = "merge"
+	flagAllocationRules = "allocation-rules"
+	flagVestingPolicy   = "vesting-policy"
+	flagDenomMetadata   = "denom-metadata"
+	flagStrict          = "strict"
+)
+
+// migrateSnapshotCmd returns the `genesis migrate-snapshot` command, which runs
+// the genesis-tool migration with the app's own codec and modules and writes
+// the result to the node's config/genesis.json.
+func migrateSnapshotCmd(encodingConfig params.EncodingConfig, basicManager module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-snapshot [<ipfs-dir>|<cid>.car]",
+		Short: "Migrate a unicorn state snapshot into the genesis file",
+		Long: `Migrate a unicorn state snapshot into config/genesis.json, as genesis-tool does.
+
+The snapshot is either given as a directory of CSV files or a CAR archive named
+after its root CID, and migrated with the default settings, or described by
+--manifest. Addresses are converted to the address prefix of this binary.
+
+With --merge the migration is merged into the existing genesis file, such as
+one from init with gentxs collected; otherwise an existing genesis file is only
+replaced with --overwrite. The result is validated like the validate command
+does.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+			genFile := config.GenesisFile()
+
+			manifest, err := migrateSnapshotManifest(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			opts, err := migrateSnapshotOptions(cmd)
+			if err != nil {
+				return err
+			}
+			opts.Log = cmd.OutOrStdout()
+
+			merge, _ := cmd.Flags().GetBool(flagMerge)
+			overwrite, _ := cmd.Flags().GetBool(genutilcli.FlagOverwrite)
+			if _, err := os.Stat(genFile); err == nil && !merge && !overwrite {
+				return fmt.Errorf("genesis file %s already exists, use --%s to merge into it or --%s to replace it", genFile, flagMerge, genutilcli.FlagOverwrite)
+			}
+			if merge {
+				opts.MergeGenesis = genFile
+			}
+
+			data, err := migrate.LoadSnapshot(manifest, opts)
+			if err != nil {
+				return err
+			}
+
+			if err := migrate.Convert(data); err != nil {
+				return err
+			}
+
+			appGenesis, err := migrate.BuildWithModules(data, migrate.Modules{
+				Codec:        encodingConfig.Codec,
+				TxConfig:     encodingConfig.TxConfig,
+				BasicManager: basicManager,
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := os.MkdirAll(filepath.Dir(genFile), 0o700); err != nil {
+				return err
+			}
+			if err := appGenesis.SaveAs(genFile); err != nil {
+				return fmt.Errorf("failed to write genesis file: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Migrated %d accounts into %s\n", data.AccountCounter, genFile)
+
+			// Check the written file exactly as `genesis validate` would
+			return genutilcli.ValidateGenesisCmd(basicManager).RunE(cmd, []string{genFile})
+		},
+	}
+
+	defaults := migrate.DefaultOptions()
+	cmd.Flags().String(flagManifest, "", "YAML or JSON manifest describing the migration, instead of a snapshot argument")
+	cmd.Flags().String(flagGenesisTime, "", "RFC 3339 genesis time, overriding the manifest")
+	cmd.Flags().String(flags.FlagChainID, "", "genesis file chain-id, overriding the manifest")
+	cmd.Flags().String(flagSupplyPolicy, defaults.SupplyPolicy, "how to handle supply that does not match balances: fail, recompute or park")
+	cmd.Flags().String(flagParkAddress, "", "account that receives unallocated supply when --supply-policy=park")
+	cmd.Flags().String(flagModuleAccounts, defaults.ModuleAccountPolicy, "where module account balances in the snapshot go: community-pool, burn or recipient")
+	cmd.Flags().String(flagModuleRecipient, "", "account that receives module account balances when --module-accounts=recipient")
+	cmd.Flags().String(flagReportDir, defaults.ReportDir, "directory the supply reconciliation, merge, row error, module account and allocation reports are written to")
+	cmd.Flags().String(flagStaking, defaults.StakingMode, "how to migrate bonded amounts: liquid or delegations")
+	cmd.Flags().String(flagValidatorKeys, "", "CSV of address,pubkey consensus keys for validators when --staking=delegations")
+	cmd.Flags().String(flagValidatorMap, "", "CSV of delegator,validator fallbacks for delegations whose validator is missing")
+	cmd.Flags().String(flagUnbonding, defaults.UnbondingPolicy, "how to migrate unbonding entries: liquid, vesting or unbonding")
+	cmd.Flags().String(flagAllocationRules, "", "YAML file of allocation rules applied to the migrated balances")
+	cmd.Flags().String(flagVestingPolicy, "", "YAML file assigning vesting schedules to migrated accounts")
+	cmd.Flags().String(flagDenomMetadata, "", "CSV of denom metadata overriding the values derived from each denom")
+	cmd.Flags().Bool(flagStrict, false, "abort on the first bad row instead of skipping and reporting it")
+	cmd.Flags().Bool(flagMerge, false, "merge the migration into the existing genesis file")
+	cmd.Flags().BoolP(genutilcli.FlagOverwrite, "o", false, "replace the existing genesis file")
+
+	return cmd
+}
+
+// Build the manifest from --manifest or the snapshot argument, targeting the
+// address prefix of the sdk config.
+func migrateSnapshotManifest(cmd *cobra.Command, args []string) (*migrate.Manifest, error) {
+	manifestPath, _ := cmd.Flags().GetString(flagManifest)
+	prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	var manifest *migrate.Manifest
+	switch {
+	case manifestPath != "" && len(args) > 0:
+		return nil, errors.New("a snapshot argument cannot be combined with --manifest")
+
+	case manifestPath != "":
+		var err error
+		manifest, err = migrate.LoadManifest(manifestPath)
+		if err != nil {
+			return nil, err
+		}
+		if manifest.Prefixes.Target != prefix {
+			return nil, fmt.Errorf("manifest migrates to address prefix %s, but this binary uses %s", manifest.Prefixes.Target, prefix)
+		}
+
+	case len(args) > 0:
+		manifest = migrate.SnapshotManifest(args[0])
+		manifest.Prefixes.Target = prefix
+
+	default:
+		return nil, errors.New("either a snapshot argument or --manifest is required")
+	}
+
+	if genesisTime, _ := cmd.Flags().GetString(flagGenesisTime); genesisTime != "" {
+		manifest.GenesisTime = genesisTime
+	}
+	if chainID, _ := cmd.Flags().GetString(flags.FlagChainID); chainID != "" {
+		manifest.ChainID = chainID
+	}
+
+	return manifest, nil
+}
+
+// Read the migration options from the command flags.
+func migrateSnapshotOptions(cmd *cobra.Command) (migrate.Options, error) {
+	opts := migrate.DefaultOptions()
+
+	for name, value := range map[string]*string{
+		flagSupplyPolicy:    &opts.SupplyPolicy,
+		flagParkAddress:     &opts.ParkAddress,
+		flagModuleAccounts:  &opts.ModuleAccountPolicy,
+		flagModuleRecipient: &opts.ModuleAccountRecipient,
+		flagReportDir:       &opts.ReportDir,
+		flagStaking:         &opts.StakingMode,
+		flagValidatorKeys:   &opts.ValidatorKeys,
+		flagValidatorMap:    &opts.ValidatorMap,
+		flagUnbonding:       &opts.UnbondingPolicy,
+		flagAllocationRules: &opts.AllocationRules,
+		flagVestingPolicy:   &opts.VestingPolicy,
+		flagDenomMetadata:   &opts.DenomMetadata,
+	} {
+		flagValue, err := cmd.Flags().GetString(name)
+		if err != nil {
+			return migrate.Options{}, err
+		}
+		*value = flagValue
+	}
+
+	strict, err := cmd.Flags().GetBool(flagStrict)
+	if err != nil {
+		return migrate.Options{}, err
+	}
+	opts.Strict = strict
+
+	return opts, opts.Validate()
+}
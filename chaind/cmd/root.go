@@ -230,7 +230,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, b
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
 		server.StatusCommand(),
-		genesisCommand(encodingConfig, basicManager),
+		genesisCommand(encodingConfig, basicManager, migrateSnapshotCmd(encodingConfig, basicManager)),
 		txCommand(),
 		queryCommand(),
 		keys.Commands(),
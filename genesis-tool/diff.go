@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/unicorn-research/chain/genesis-tool/migrate"
+)
+
+// runDiff performs the diff subcommand, comparing two genesis files or
+// snapshots.
+func runDiff(args []string) error {
+	flags := flag.NewFlagSet("genesis-tool diff", flag.ContinueOnError)
+	jsonOutput := flags.Bool("json", false, "print the diff as JSON")
+	manifestPath := flags.String("manifest", "", "YAML or JSON manifest snapshots are migrated with, instead of the default migration")
+	supplyPolicy := flags.String("supply-policy", migrate.SupplyPolicyFail, "how to handle supply that does not match balances when migrating a snapshot: fail, recompute or park")
+	parkAddress := flags.String("park-address", "", "account that receives unallocated supply when --supply-policy=park")
+	stakingMode := flags.String("staking", migrate.StakingModeLiquid, "how to migrate bonded amounts of a snapshot: liquid or delegations")
+	validatorKeys := flags.String("validator-keys", "", "CSV of address,pubkey consensus keys for validators when --staking=delegations")
+	unbondingPolicy := flags.String("unbonding", migrate.UnbondingPolicyLiquid, "how to migrate unbonding entries of a snapshot: liquid, vesting or unbonding")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 2 {
+		return errors.New("usage: genesis-tool diff [flags] <old> <new>, each a genesis .json file, an <ipfs-dir> or a <cid>.car")
+	}
+
+	manifest := migrate.DefaultManifest()
+	if *manifestPath != "" {
+		var err error
+		manifest, err = migrate.LoadManifest(*manifestPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Reports of the migrations are not kept
+	reportDir, err := os.MkdirTemp("", "genesis-diff-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(reportDir)
+
+	opts := migrate.DefaultOptions()
+	opts.SupplyPolicy = *supplyPolicy
+	opts.ParkAddress = *parkAddress
+	opts.StakingMode = *stakingMode
+	opts.ValidatorKeys = *validatorKeys
+	opts.UnbondingPolicy = *unbondingPolicy
+	opts.ReportDir = reportDir
+	opts.Log = os.Stderr
+
+	oldGenesis, err := migrate.LoadGenesis(flags.Arg(0), manifest, opts)
+	if err != nil {
+		return err
+	}
+
+	newGenesis, err := migrate.LoadGenesis(flags.Arg(1), manifest, opts)
+	if err != nil {
+		return err
+	}
+
+	diff, err := migrate.DiffGenesis(oldGenesis, newGenesis)
+	if err != nil {
+		return err
+	}
+
+	if *jsonOutput {
+		diffJSON, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal diff: %w", err)
+		}
+		fmt.Println(string(diffJSON))
+		return nil
+	}
+
+	return diff.WriteText(os.Stdout)
+}
@@ -1,3 +0,0 @@
-module github.com/unicorn-research/genesis-tool
-
-go 1.24.0
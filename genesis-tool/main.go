@@ -1,115 +1,16 @@
 package main
 
 import (
-	"encoding/csv"
-	"encoding/json"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"flag"
 	"fmt"
 	"io"
 	"os"
-	"path/filepath"
-	"sort"
-	"strconv"
-	"strings"
 	"time"
-)
-
-// Define types for our genesis file.
-type GenesisAccount struct {
-	Address       string  `json:"address"`
-	AccountNumber string  `json:"account_number"`
-	Sequence      string  `json:"sequence"`
-	PubKey        *PubKey `json:"pub_key,omitempty"`
-}
-
-type PubKey struct {
-	Type  string `json:"@type"`
-	Value string `json:"key"`
-}
-
-type Coin struct {
-	Denom  string `json:"denom"`
-	Amount string `json:"amount"`
-}
-
-type Balance struct {
-	Address string `json:"address"`
-	Coins   []Coin `json:"coins"`
-}
-
-type AuthGenesis struct {
-	Accounts []GenesisAccount `json:"accounts"`
-	Params   AuthParams       `json:"params"`
-}
-
-type AuthParams struct {
-	MaxMemoCharacters      string `json:"max_memo_characters"`
-	TxSigLimit             string `json:"tx_sig_limit"`
-	TxSizeCostPerByte      string `json:"tx_size_cost_per_byte"`
-	SigVerifyCostEd25519   string `json:"sig_verify_cost_ed25519"`
-	SigVerifyCostSecp256k1 string `json:"sig_verify_cost_secp256k1"`
-}
-
-type BankGenesis struct {
-	Params        BankParams `json:"params"`
-	Balances      []Balance  `json:"balances"`
-	Supply        []Coin     `json:"supply"`
-	DenomMetadata []Metadata `json:"denom_metadata"`
-}
-
-type BankParams struct {
-	SendEnabled        []SendEnabled `json:"send_enabled"`
-	DefaultSendEnabled bool          `json:"default_send_enabled"`
-}
-
-type SendEnabled struct {
-	Denom   string `json:"denom"`
-	Enabled bool   `json:"enabled"`
-}
-
-type Metadata struct {
-	Description string      `json:"description"`
-	DenomUnits  []DenomUnit `json:"denom_units"`
-	Base        string      `json:"base"`
-	Display     string      `json:"display"`
-	Name        string      `json:"name"`
-	Symbol      string      `json:"symbol"`
-}
-
-type DenomUnit struct {
-	Denom    string   `json:"denom"`
-	Exponent uint32   `json:"exponent"`
-	Aliases  []string `json:"aliases,omitempty"`
-}
 
-type GenesisDoc struct {
-	GenesisTime     time.Time      `json:"genesis_time"`
-	ChainID         string         `json:"chain_id"`
-	InitialHeight   string         `json:"initial_height"`
-	ConsensusParams map[string]any `json:"consensus_params"`
-	AppState        AppState       `json:"app_state"`
-}
-
-type AppState struct {
-	Auth    AuthGenesis    `json:"auth"`
-	Bank    BankGenesis    `json:"bank"`
-	Staking map[string]any `json:"staking"`
-	Genutil map[string]any `json:"genutil"`
-	// Other modules can be added as needed
-}
-
-// Holds our internal processing data.
-type GenesisData struct {
-	Accounts       map[string]*GenesisAccount
-	Balances       map[string][]Coin
-	Supply         []Coin
-	AccountCounter int // Counter for assigning sequential account numbers
-}
-
-// Constant for address prefix conversion.
-const (
-	OldPrefix = "unicorn"
-	NewPrefix = "gadikian"
+	"github.com/unicorn-research/chain/genesis-tool/migrate"
 )
 
 func main() {
@@ -122,532 +23,153 @@ func main() {
 
 // run performs the main logic of the program.
 func run() error {
-	// Check if IPFS directory is provided
-	if len(os.Args) < 2 {
-		return errors.New("usage: genesis-tool <ipfs-dir> [chain-id]")
-	}
-
-	ipfsDir := os.Args[1]
-	chainID := "gadikian-1" // Default chain-id changed to gadikian
-	if len(os.Args) > 2 {
-		chainID = os.Args[2]
-	} else if strings.HasPrefix(chainID, "unicorn") {
-		// Make sure we're using the gadikian chain ID if not specified
-		chainID = strings.Replace(chainID, "unicorn", "gadikian", 1)
-	}
-
-	// Initialize genesis data
-	genesisData := &GenesisData{
-		Accounts:       make(map[string]*GenesisAccount),
-		Balances:       make(map[string][]Coin),
-		Supply:         make([]Coin, 0),
-		AccountCounter: 0, // Initialize the account counter
-	}
-
-	// Process files
-	if err := processFiles(ipfsDir, genesisData); err != nil {
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		return runDiff(os.Args[2:])
+	}
+
+	flags := flag.NewFlagSet("genesis-tool", flag.ContinueOnError)
+	manifestPath := flags.String("manifest", "", "YAML or JSON manifest describing the migration")
+	genesisTimeFlag := flags.String("genesis-time", "", "RFC 3339 genesis time, overriding the manifest")
+	supplyPolicy := flags.String("supply-policy", migrate.SupplyPolicyFail, "how to handle supply that does not match balances: fail, recompute or park")
+	parkAddress := flags.String("park-address", "", "account that receives unallocated supply when --supply-policy=park")
+	reportDir := flags.String("report-dir", ".", "directory the supply reconciliation, merge, row error, module account and allocation reports are written to")
+	moduleAccountPolicy := flags.String("module-accounts", migrate.ModuleAccountPolicyCommunityPool, "where module account balances in the snapshot go: community-pool, burn or recipient")
+	moduleAccountRecipient := flags.String("module-account-recipient", "", "account that receives module account balances when --module-accounts=recipient")
+	stakingMode := flags.String("staking", migrate.StakingModeLiquid, "how to migrate bonded amounts: liquid or delegations")
+	validatorKeys := flags.String("validator-keys", "", "CSV of address,pubkey consensus keys for validators when --staking=delegations")
+	validatorMap := flags.String("validator-map", "", "CSV of delegator,validator fallbacks for delegations whose validator is missing")
+	unbondingPolicy := flags.String("unbonding", migrate.UnbondingPolicyLiquid, "how to migrate unbonding entries: liquid, vesting or unbonding")
+	mergeGenesis := flags.String("merge", "", "existing genesis, e.g. from chaind init with gentxs collected, to merge the migration into")
+	allocationRules := flags.String("allocation-rules", "", "YAML file of allocation rules applied to the migrated balances")
+	vestingPolicy := flags.String("vesting-policy", "", "YAML file assigning vesting schedules to migrated accounts")
+	denomMetadata := flags.String("denom-metadata", "", "CSV of denom metadata overriding the values derived from each denom")
+	strict := flags.Bool("strict", false, "abort on the first bad row instead of skipping and reporting it")
+	stream := flags.Bool("stream", false, "sort balances on disk and stream the genesis, for snapshots too large for memory")
+	spillBuffer := flags.Int("spill-buffer", migrate.DefaultSpillBuffer, "credits held in memory before they are sorted to disk when --stream is set")
+	if err := flags.Parse(os.Args[1:]); err != nil {
 		return err
 	}
 
-	// Convert prefixes
-	fmt.Println("Converting bech32 prefixes from 'unicorn' to 'gadikian'...")
-	convertPrefixes(genesisData)
-
-	// Create final genesis.json
-	fmt.Println("Generating genesis.json...")
-	if err := generateGenesisJSON(genesisData, chainID); err != nil {
-		return fmt.Errorf("error generating genesis JSON: %w", err)
-	}
-
-	fmt.Println("Genesis file created successfully: genesis.json")
-	fmt.Printf("Chain ID: %s\n", chainID)
-	fmt.Println("Addresses converted from unicorn prefix to gadikian prefix")
-	fmt.Println("Token denoms converted from uwunicorn to ugadikian")
-	fmt.Printf("Total accounts created: %d\n", genesisData.AccountCounter)
-
-	return nil
-}
-
-// processFiles processes all CSV files and populates the genesis data.
-func processFiles(ipfsDir string, data *GenesisData) error {
-	// Process balances.csv if it exists
-	fmt.Println("Processing balances.csv...")
-	balancesPath := filepath.Join(ipfsDir, "balances.csv")
-	if _, err := os.Stat(balancesPath); err == nil {
-		if err := processBalances(balancesPath, data); err != nil {
-			return fmt.Errorf("error processing balances: %w", err)
-		}
-	} else {
-		fmt.Println("balances.csv not found, skipping...")
-	}
-
-	// Process supply.csv
-	fmt.Println("Processing supply.csv...")
-	supplyPath := filepath.Join(ipfsDir, "supply.csv")
-	if err := processSupply(supplyPath, data); err != nil {
-		return fmt.Errorf("error processing supply: %w", err)
-	}
-
-	// Process kaway_bond.csv
-	fmt.Println("Processing kaway_bond.csv...")
-	kawayPath := filepath.Join(ipfsDir, "kaway_bond.csv")
-	if err := processBonds(kawayPath, "uwunicorn", data); err != nil {
-		return fmt.Errorf("error processing kaway_bond: %w", err)
-	}
-
-	// Process uwuval_bond.csv if it exists
-	fmt.Println("Processing uwuval_bond.csv...")
-	uwuvalPath := filepath.Join(ipfsDir, "uwuval_bond.csv")
-	if _, err := os.Stat(uwuvalPath); err == nil {
-		if err := processBonds(uwuvalPath, "valuwunicorn", data); err != nil {
-			return fmt.Errorf("error processing uwuval_bond: %w", err)
-		}
-	} else {
-		fmt.Println("uwuval_bond.csv not found, skipping...")
-	}
-
-	// Process LP files
-	fmt.Println("Processing liquidity pool data...")
-	processLPs(ipfsDir)
-
-	return nil
-}
-
-// Convert unicorn prefixes to gadikian.
-func convertPrefixes(data *GenesisData) {
-	// Convert account addresses
-	convertedAccounts := make(map[string]*GenesisAccount)
-	for oldAddress, account := range data.Accounts {
-		newAddress := convertAddress(oldAddress)
-		// Also update the address in the account itself
-		account.Address = newAddress
-		convertedAccounts[newAddress] = account
-	}
-	data.Accounts = convertedAccounts
-
-	// Convert balances addresses and denoms
-	convertedBalances := make(map[string][]Coin)
-	for oldAddress, coins := range data.Balances {
-		newAddress := convertAddress(oldAddress)
-
-		// Convert denoms in coins
-		convertedCoins := make([]Coin, len(coins))
-		for i, coin := range coins {
-			convertedCoins[i] = Coin{
-				Denom:  convertDenom(coin.Denom),
-				Amount: coin.Amount,
-			}
-		}
-
-		convertedBalances[newAddress] = convertedCoins
+	manifest, err := manifestFromArgs(*manifestPath, flags.Args())
+	if err != nil {
+		return err
 	}
-	data.Balances = convertedBalances
 
-	// Convert supply denoms
-	for i, coin := range data.Supply {
-		data.Supply[i] = Coin{
-			Denom:  convertDenom(coin.Denom),
-			Amount: coin.Amount,
-		}
+	if *genesisTimeFlag != "" {
+		manifest.GenesisTime = *genesisTimeFlag
 	}
 
-	// Debug output
-	fmt.Printf("Converted %d account addresses\n", len(data.Accounts))
-	fmt.Printf("Converted %d balance entries\n", len(data.Balances))
-	fmt.Printf("Converted %d supply entries\n", len(data.Supply))
-}
-
-// Convert a unicorn address to a gadikian address.
-func convertAddress(address string) string {
-	if strings.HasPrefix(address, OldPrefix) {
-		return NewPrefix + address[len(OldPrefix):]
-	}
-	return address
-}
+	opts := migrate.Options{
+		StakingMode:     *stakingMode,
+		ValidatorKeys:   *validatorKeys,
+		ValidatorMap:    *validatorMap,
+		UnbondingPolicy: *unbondingPolicy,
+		SupplyPolicy:    *supplyPolicy,
+		ParkAddress:     *parkAddress,
 
-// Convert a denom from unicorn-prefixed to gadikian-prefixed.
-func convertDenom(denom string) string {
-	// Convert uwunicorn -> ugadikian
-	if denom == "uwunicorn" {
-		return "ugadikian"
-	}
+		ModuleAccountPolicy:    *moduleAccountPolicy,
+		ModuleAccountRecipient: *moduleAccountRecipient,
 
-	// Convert valuwunicorn -> valgadikian
-	if denom == "valuwunicorn" {
-		return "valgadikian"
+		AllocationRules: *allocationRules,
+		VestingPolicy:   *vestingPolicy,
+		DenomMetadata:   *denomMetadata,
+		MergeGenesis:    *mergeGenesis,
+		ReportDir:       *reportDir,
+		Strict:          *strict,
+		Log:             os.Stdout,
+		Stream:          *stream,
+		SpillBuffer:     *spillBuffer,
 	}
 
-	// Convert factory/unicorn.../uXXX -> factory/gadikian.../uXXX
-	if strings.HasPrefix(denom, "factory/"+OldPrefix) {
-		return "factory/" + NewPrefix + denom[len("factory/"+OldPrefix):]
+	// Read the snapshot
+	genesisData, err := migrate.LoadSnapshot(manifest, opts)
+	if err != nil {
+		return err
 	}
+	defer genesisData.Close()
 
-	return denom
-}
-
-// Process bonds from a CSV file (kaway_bond.csv or uwuval_bond.csv).
-func processBonds(filePath, denom string, data *GenesisData) error {
-	// Check if file exists
-	if _, err := os.Stat(filePath); err != nil {
-		fmt.Printf("%s not found, skipping...\n", filepath.Base(filePath))
-		return nil
+	// Convert it to the target chain
+	if err := migrate.Convert(genesisData); err != nil {
+		return err
 	}
 
-	// Open the CSV file
-	file, err := os.Open(filePath)
-	if err != nil {
-		return fmt.Errorf("failed to open %s: %w", filepath.Base(filePath), err)
+	// Create final genesis.json
+	if err := writeGenesis(genesisData, "genesis.json"); err != nil {
+		return err
 	}
-	defer file.Close()
-
-	// Create a new CSV reader
-	reader := csv.NewReader(file)
 
-	// Read the header
-	header, err := reader.Read()
+	hash, err := fileSHA256("genesis.json")
 	if err != nil {
-		return fmt.Errorf("failed to read header: %w", err)
-	}
-
-	// Expected header: address,uwu or similar
-	if len(header) < 2 || header[0] != "address" {
-		return fmt.Errorf("unexpected header format in %s, expected: address,amount", filepath.Base(filePath))
+		return err
 	}
 
-	return processCsvRows(reader, data, denom)
-}
-
-// Process CSV rows to extract bond data.
-func processCsvRows(reader *csv.Reader, data *GenesisData, denom string) error {
-	// Process rows
-	for {
-		row, err := reader.Read()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return fmt.Errorf("error reading row: %w", err)
-		}
-
-		// Parse data
-		address := row[0]
-		amount := row[1]
-
-		// Skip empty amounts
-		if amount == "" || amount == "0" {
-			continue
-		}
-
-		// Create coin
-		coin := Coin{
-			Denom:  denom,
-			Amount: amount,
-		}
-
-		// Ensure account exists
-		if _, exists := data.Accounts[address]; !exists {
-			accountNumber := data.AccountCounter
-			data.AccountCounter++
-
-			data.Accounts[address] = &GenesisAccount{
-				Address:       address,
-				AccountNumber: fmt.Sprintf("%d", accountNumber),
-				Sequence:      "0",
-			}
-		}
-
-		// Add coin to balances
-		data.Balances[address] = append(data.Balances[address], coin)
+	fmt.Println("Genesis file created successfully: genesis.json")
+	fmt.Printf("Genesis SHA-256: %s\n", hash)
+	fmt.Printf("Chain ID: %s\n", manifest.ChainID)
+	fmt.Printf("Genesis time: %s\n", genesisData.GenesisTime.Format(time.RFC3339))
+	fmt.Printf("Addresses converted from %s prefix to %s prefix\n", manifest.Prefixes.Source, manifest.Prefixes.Target)
+	for _, rule := range manifest.Denoms.Rename {
+		fmt.Printf("Token denoms converted from %s to %s\n", rule.From, rule.To)
 	}
+	fmt.Printf("Total accounts created: %d\n", genesisData.AccountCounter)
 
 	return nil
 }
 
-// Process balances.csv - expected to be complex with many columns.
-func processBalances(filePath string, data *GenesisData) error {
-	// Open the CSV file
-	file, err := os.Open(filePath)
-	if err != nil {
-		return fmt.Errorf("failed to open balances.csv: %w", err)
+// Load the manifest given with --manifest. The tool takes no positional
+// arguments: the snapshot, the chain-id and every other setting of the
+// migration come from the manifest.
+func manifestFromArgs(manifestPath string, args []string) (*migrate.Manifest, error) {
+	if len(args) > 0 {
+		return nil, fmt.Errorf("unexpected arguments %q, the snapshot is given in the manifest", args)
 	}
-	defer file.Close()
-
-	// Create a new CSV reader
-	reader := csv.NewReader(file)
-
-	// Read the header to determine columns
-	header, err := reader.Read()
-	if err != nil {
-		return fmt.Errorf("failed to read header: %w", err)
+	if manifestPath == "" {
+		return nil, errors.New("usage: genesis-tool [flags] --manifest <file> | genesis-tool diff [flags] <old> <new>")
 	}
 
-	// First column should be 'address'
-	if header[0] != "address" {
-		return errors.New("unexpected header format in balances.csv, first column should be 'address'")
-	}
-
-	return processBalanceRows(reader, header, data)
+	return migrate.LoadManifest(manifestPath)
 }
 
-// Process balance rows from CSV.
-func processBalanceRows(reader *csv.Reader, header []string, data *GenesisData) error {
-	// Process rows
-	for {
-		row, err := reader.Read()
-		if err == io.EOF {
-			break
-		}
+// Build the genesis of the converted data and write it to path, streaming it
+// when the migration was loaded with --stream.
+func writeGenesis(data *migrate.GenesisData, path string) error {
+	if !data.Options.Stream {
+		appGenesis, err := migrate.Build(data)
 		if err != nil {
-			return fmt.Errorf("error reading row: %w", err)
-		}
-
-		if err := processBalanceRow(row, header, data); err != nil {
 			return err
 		}
-	}
-
-	return nil
-}
-
-// Process a single balance row.
-func processBalanceRow(row []string, header []string, data *GenesisData) error {
-	// Parse address
-	address := row[0]
-
-	// Ensure account exists
-	if _, exists := data.Accounts[address]; !exists {
-		accountNumber := data.AccountCounter
-		data.AccountCounter++
-
-		data.Accounts[address] = &GenesisAccount{
-			Address:       address,
-			AccountNumber: fmt.Sprintf("%d", accountNumber),
-			Sequence:      "0",
-		}
-	}
-
-	// Parse balances for each denom in the header
-	var coins []Coin
-	for i := 1; i < len(header) && i < len(row); i++ {
-		denom := header[i]
-		amount := row[i]
-
-		// Skip empty amounts
-		if amount == "" || amount == "0" {
-			continue
-		}
-
-		// Add coin
-		coins = append(coins, Coin{
-			Denom:  denom,
-			Amount: amount,
-		})
-	}
 
-	// Add to balances
-	if len(coins) > 0 {
-		if existing, ok := data.Balances[address]; ok {
-			data.Balances[address] = append(existing, coins...)
-		} else {
-			data.Balances[address] = coins
+		if err := appGenesis.SaveAs(path); err != nil {
+			return fmt.Errorf("error writing genesis JSON: %w", err)
 		}
+		return nil
 	}
 
-	return nil
-}
-
-// Process supply.csv.
-func processSupply(filePath string, data *GenesisData) error {
-	// Open the CSV file
-	file, err := os.Open(filePath)
+	file, err := os.Create(path)
 	if err != nil {
-		return fmt.Errorf("failed to open supply.csv: %w", err)
+		return fmt.Errorf("error writing genesis JSON: %w", err)
 	}
 	defer file.Close()
 
-	// Create a new CSV reader
-	reader := csv.NewReader(file)
-
-	// Read the header
-	header, err := reader.Read()
-	if err != nil {
-		return fmt.Errorf("failed to read header: %w", err)
-	}
-
-	// Expected header: denom,amount
-	if len(header) < 2 || header[0] != "denom" || header[1] != "amount" {
-		return errors.New("unexpected header format in supply.csv, expected: denom,amount")
-	}
-
-	// Process rows
-	for {
-		row, err := reader.Read()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return fmt.Errorf("error reading row: %w", err)
-		}
-
-		// Parse data
-		denom := row[0]
-		amount := row[1]
-
-		// Add to supply
-		data.Supply = append(data.Supply, Coin{
-			Denom:  denom,
-			Amount: amount,
-		})
-	}
-
-	return nil
-}
-
-// Process pool_bals.csv and lp_bals.csv.
-func processLPs(ipfsDir string) {
-	// This is a simplified implementation that just reports files found
-	// In a real-world scenario, you would need to process LP-related data
-
-	// Check for pool_bals.csv
-	poolFilePath := filepath.Join(ipfsDir, "pool_bals.csv")
-	if _, err := os.Stat(poolFilePath); err == nil {
-		fmt.Println("pool_bals.csv found, but specialized LP processing not implemented")
-	}
-
-	// Check for lp_bals.csv
-	lpFilePath := filepath.Join(ipfsDir, "lp_bals.csv")
-	if _, err := os.Stat(lpFilePath); err == nil {
-		fmt.Println("lp_bals.csv found, but specialized LP processing not implemented")
+	if err := migrate.StreamGenesis(data, file); err != nil {
+		return err
 	}
 
-	// Check for total_lps.csv
-	totalLPsFilePath := filepath.Join(ipfsDir, "total_lps.csv")
-	if _, err := os.Stat(totalLPsFilePath); err == nil {
-		fmt.Println("total_lps.csv found, but specialized LP processing not implemented")
-	}
+	return file.Close()
 }
 
-// Generate the final genesis.json file.
-func generateGenesisJSON(data *GenesisData, chainID string) error {
-	// Create auth genesis
-	authGenesis := AuthGenesis{
-		Params: AuthParams{
-			MaxMemoCharacters:      "256",
-			TxSigLimit:             "7",
-			TxSizeCostPerByte:      "10",
-			SigVerifyCostEd25519:   "590",
-			SigVerifyCostSecp256k1: "1000",
-		},
-		Accounts: make([]GenesisAccount, 0, len(data.Accounts)),
-	}
-
-	// Convert accounts map to slice
-	var accounts []GenesisAccount
-	for _, account := range data.Accounts {
-		accounts = append(accounts, *account)
-	}
-
-	// Sort accounts by account number
-	sort.Slice(accounts, func(i, j int) bool {
-		numI, _ := strconv.Atoi(accounts[i].AccountNumber)
-		numJ, _ := strconv.Atoi(accounts[j].AccountNumber)
-		return numI < numJ
-	})
-
-	// Add sorted accounts to auth genesis
-	authGenesis.Accounts = accounts
-
-	// Create bank genesis
-	bankGenesis := BankGenesis{
-		Params: BankParams{
-			SendEnabled:        []SendEnabled{},
-			DefaultSendEnabled: true,
-		},
-		Balances:      make([]Balance, 0, len(data.Balances)),
-		Supply:        data.Supply,
-		DenomMetadata: []Metadata{},
-	}
-
-	// Convert balances map to slice
-	for address, coins := range data.Balances {
-		bankGenesis.Balances = append(bankGenesis.Balances, Balance{
-			Address: address,
-			Coins:   coins,
-		})
-	}
-
-	// Create app state
-	appState := AppState{
-		Auth:    authGenesis,
-		Bank:    bankGenesis,
-		Staking: map[string]any{},
-		Genutil: map[string]any{},
-	}
-
-	// Create final genesis document
-	genesisDoc := GenesisDoc{
-		GenesisTime:   time.Now(),
-		ChainID:       chainID,
-		InitialHeight: "1",
-		ConsensusParams: map[string]any{
-			"block": map[string]any{
-				"max_bytes": "22020096",
-				"max_gas":   "-1",
-			},
-			"evidence": map[string]any{
-				"max_age_num_blocks": "100000",
-				"max_age_duration":   "172800000000000",
-			},
-			"validator": map[string]any{
-				"pub_key_types": []string{"ed25519"},
-			},
-			"version": map[string]any{},
-		},
-		AppState: appState,
-	}
-
-	// Marshal the genesis document with pretty printing
-	genesisBz, err := json.MarshalIndent(genesisDoc, "", "  ")
-	if err != nil {
-		return fmt.Errorf("failed to marshal genesis doc: %w", err)
-	}
-
-	// Write to file first
-	tempFilePath := "genesis.json.temp"
-	err = os.WriteFile(tempFilePath, genesisBz, 0o600)
-	if err != nil {
-		return fmt.Errorf("failed to write temporary genesis file: %w", err)
-	}
-
-	// Read the file back in
-	fileContent, err := os.ReadFile(tempFilePath)
+// Return the hex-encoded SHA-256 of a file.
+func fileSHA256(path string) (string, error) {
+	file, err := os.Open(path)
 	if err != nil {
-		return fmt.Errorf("failed to read temporary genesis file: %w", err)
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
 	}
+	defer file.Close()
 
-	// Convert to string for replacements
-	jsonStr := string(fileContent)
-
-	// Perform all prefix replacements
-	jsonStr = strings.ReplaceAll(jsonStr, "\"unicorn1", "\"gadikian1")
-	jsonStr = strings.ReplaceAll(jsonStr, "\"uwunicorn\"", "\"ugadikian\"")
-	jsonStr = strings.ReplaceAll(jsonStr, "\"valuwunicorn\"", "\"valgadikian\"")
-	jsonStr = strings.Replace(jsonStr, "\"chain_id\": \"unicorn-1\"", "\"chain_id\": \"gadikian-1\"", 1)
-
-	// Log what we did
-	fmt.Println("Converted bech32 prefixes from 'unicorn' to 'gadikian'")
-	fmt.Println("Converted token denominations from 'uwunicorn' to 'ugadikian'")
-	fmt.Println("Converted validator token denominations from 'valuwunicorn' to 'valgadikian'")
-	fmt.Println("Set chain ID to 'gadikian-1'")
-
-	// Write to final file
-	err = os.WriteFile("genesis.json", []byte(jsonStr), 0o600)
-	if err != nil {
-		return fmt.Errorf("failed to write final genesis file: %w", err)
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
 	}
 
-	// Clean up temp file
-	os.Remove(tempFilePath)
-
-	return nil
+	return hex.EncodeToString(hash.Sum(nil)), nil
 }
@@ -0,0 +1,348 @@
+package migrate
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// Allocation rule types.
+const (
+	// AllocationRuleExclude zeroes the balance of listed addresses.
+	AllocationRuleExclude = "exclude"
+	// AllocationRuleBondedMultiplier scales the part of each balance that was bonded.
+	AllocationRuleBondedMultiplier = "bonded_multiplier"
+	// AllocationRuleMinBalance zeroes balances below a threshold.
+	AllocationRuleMinBalance = "min_balance"
+	// AllocationRuleCap limits balances to a maximum.
+	AllocationRuleCap = "cap"
+	// AllocationRuleCommunityPoolTopUp credits whatever the other rules removed to the community pool.
+	AllocationRuleCommunityPoolTopUp = "community_pool_top_up"
+)
+
+// AllocationRulesSource is the ledger source of balances changed by the allocation rules.
+const AllocationRulesSource = "allocation rules"
+
+// AllocationRules reshape the migrated balances of a single denom.
+type AllocationRules struct {
+	// Denom is the denom the rules apply to, as it appears in the snapshot or
+	// in the genesis; it defaults to the bond denom.
+	Denom string           `yaml:"denom"`
+	Rules []AllocationRule `yaml:"rules"`
+}
+
+// AllocationRule is a single step of the allocation rules.
+type AllocationRule struct {
+	Name string `yaml:"name"`
+	Type string `yaml:"type"`
+
+	Addresses  []string `yaml:"addresses"`  // Exclude only, under either prefix
+	Multiplier string   `yaml:"multiplier"` // Bonded multiplier only, a decimal such as 1.5
+	Amount     string   `yaml:"amount"`     // Min balance and cap only
+}
+
+// AllocationRuleReport describes what a single allocation rule changed.
+type AllocationRuleReport struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Accounts int    `json:"accounts"` // Accounts whose balance the rule changed
+	Added    string `json:"added"`
+	Removed  string `json:"removed"`
+}
+
+// AllocationChange is a balance changed by a single allocation rule.
+type AllocationChange struct {
+	Rule    string
+	Address string
+	Before  math.Int
+	After   math.Int
+}
+
+// Load an allocation rules file.
+func loadAllocationRules(path string) (*AllocationRules, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read allocation rules: %w", err)
+	}
+
+	// Reject unknown fields, so a misspelled setting is not silently ignored
+	decoder := yaml.NewDecoder(bytes.NewReader(bz))
+	decoder.KnownFields(true)
+
+	rules := &AllocationRules{}
+	if err := decoder.Decode(rules); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse allocation rules %s: %w", filepath.Base(path), err)
+	}
+
+	return rules, nil
+}
+
+// Apply the allocation rules in rulesPath to the migrated balances.
+//
+// Rules run in order, each on the balances left by the one before. They only
+// touch the rules' denom on migrated accounts: module account balances were
+// already redirected, and the community pool they may have funded is left as
+// it is, like the staking pools funded afterwards. What each rule changed is
+// written to allocation_report.json and allocation_changes.csv in reportDir.
+func applyAllocationRules(data *GenesisData, rulesPath, reportDir string) error {
+	rules, err := loadAllocationRules(rulesPath)
+	if err != nil {
+		return err
+	}
+
+	denom := rules.Denom
+	if denom == "" {
+		denom = BondDenom
+	}
+	denom, err = data.Manifest.convertDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	moduleAccounts, err := data.Manifest.moduleAccounts()
+	if err != nil {
+		return err
+	}
+
+	var addresses []string
+	for _, address := range data.Balances.addresses() {
+		if _, ok := moduleAccounts[address]; !ok {
+			addresses = append(addresses, address)
+		}
+	}
+	amounts := make(map[string]math.Int, len(addresses))
+	before := math.ZeroInt()
+	for _, address := range addresses {
+		amounts[address] = data.Balances.balance(address).AmountOf(denom)
+		before = before.Add(amounts[address])
+	}
+
+	var (
+		reports []AllocationRuleReport
+		changes []AllocationChange
+		topUp   *AllocationRule
+	)
+	for i, rule := range rules.Rules {
+		if rule.Name == "" {
+			return fmt.Errorf("allocation rule %d needs a name", i+1)
+		}
+
+		if rule.Type == AllocationRuleCommunityPoolTopUp {
+			if i != len(rules.Rules)-1 {
+				return fmt.Errorf("allocation rule %s: %s must be the last rule", rule.Name, rule.Type)
+			}
+			topUp = &rules.Rules[i]
+			continue
+		}
+
+		apply, err := allocationRuleFunc(data, rule, denom)
+		if err != nil {
+			return fmt.Errorf("allocation rule %s: %w", rule.Name, err)
+		}
+
+		report := AllocationRuleReport{Name: rule.Name, Type: rule.Type}
+		added, removed := math.ZeroInt(), math.ZeroInt()
+		for _, address := range addresses {
+			current := amounts[address]
+			next := apply(address, current)
+			if next.Equal(current) {
+				continue
+			}
+
+			if next.GT(current) {
+				added = added.Add(next.Sub(current))
+			} else {
+				removed = removed.Add(current.Sub(next))
+			}
+			report.Accounts++
+			changes = append(changes, AllocationChange{Rule: rule.Name, Address: address, Before: current, After: next})
+			amounts[address] = next
+
+			// Update the ledger as each rule runs, so the next one reads what
+			// is left of each source's credits
+			if err := data.Balances.setAmount(address, AllocationRulesSource, denom, next); err != nil {
+				return err
+			}
+		}
+		report.Added, report.Removed = added.String(), removed.String()
+		reports = append(reports, report)
+	}
+
+	if topUp != nil {
+		report, err := topUpCommunityPool(data, *topUp, denom, before, amounts)
+		if err != nil {
+			return fmt.Errorf("allocation rule %s: %w", topUp.Name, err)
+		}
+		reports = append(reports, report)
+	}
+
+	for _, report := range reports {
+		data.logf("Allocation rule %s (%s): changed %d accounts, added %s%s, removed %s%s\n",
+			report.Name, report.Type, report.Accounts, report.Added, denom, report.Removed, denom)
+	}
+
+	return writeAllocationReport(reports, changes, reportDir)
+}
+
+// Return the function a balance-changing rule applies to each account.
+func allocationRuleFunc(data *GenesisData, rule AllocationRule, denom string) (func(address string, amount math.Int) math.Int, error) {
+	switch rule.Type {
+	case AllocationRuleExclude:
+		excluded := make(map[string]bool, len(rule.Addresses))
+		for _, address := range rule.Addresses {
+			converted, err := data.Manifest.convertAddress(address)
+			if err != nil {
+				return nil, err
+			}
+			excluded[converted] = true
+		}
+
+		return func(address string, amount math.Int) math.Int {
+			if excluded[address] {
+				return math.ZeroInt()
+			}
+			return amount
+		}, nil
+
+	case AllocationRuleBondedMultiplier:
+		if data.StakingMode != StakingModeLiquid {
+			return nil, errors.New("bonded amounts are only balances with --staking=liquid")
+		}
+
+		multiplier, err := math.LegacyNewDecFromStr(rule.Multiplier)
+		if err != nil || multiplier.IsNegative() {
+			return nil, fmt.Errorf("invalid multiplier %q", rule.Multiplier)
+		}
+
+		// The bond files are credited under their file names, see InputFiles.source
+		input := data.Manifest.Input
+		bondSources := []string{input.source(input.KawayBond), input.source(input.UwuvalBond)}
+		return func(address string, amount math.Int) math.Int {
+			bonded := math.MinInt(data.Balances.amountFrom(address, denom, bondSources...), amount)
+			return amount.Sub(bonded).Add(multiplier.MulInt(bonded).TruncateInt())
+		}, nil
+
+	case AllocationRuleMinBalance:
+		threshold, err := parseRuleAmount(rule.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(_ string, amount math.Int) math.Int {
+			if amount.LT(threshold) {
+				return math.ZeroInt()
+			}
+			return amount
+		}, nil
+
+	case AllocationRuleCap:
+		limit, err := parseRuleAmount(rule.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(_ string, amount math.Int) math.Int {
+			return math.MinInt(amount, limit)
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown rule type %q", rule.Type)
+	}
+}
+
+// Parse the amount of a min balance or cap rule.
+func parseRuleAmount(value string) (math.Int, error) {
+	if value == "" {
+		return math.Int{}, errors.New("amount must be set")
+	}
+
+	amount, rowErr := parseAmountField("", 0, "amount", value)
+	if rowErr != nil {
+		return math.Int{}, fmt.Errorf("invalid amount %q: %s", value, rowErr.Reason)
+	}
+
+	return amount, nil
+}
+
+// Credit the amount the other rules removed from the snapshot total to the
+// community pool, so the total supply stays what it was.
+func topUpCommunityPool(data *GenesisData, rule AllocationRule, denom string, before math.Int, amounts map[string]math.Int) (AllocationRuleReport, error) {
+	after := math.ZeroInt()
+	for _, amount := range amounts {
+		after = after.Add(amount)
+	}
+
+	if after.GT(before) {
+		return AllocationRuleReport{}, fmt.Errorf("rules allocate %s%s more than the snapshot holds, so the supply cannot be kept", after.Sub(before), denom)
+	}
+
+	topUp := before.Sub(after)
+	report := AllocationRuleReport{Name: rule.Name, Type: rule.Type, Added: topUp.String(), Removed: "0"}
+	if topUp.IsZero() {
+		return report, nil
+	}
+
+	address, err := sdk.Bech32ifyAddressBytes(data.Manifest.Prefixes.Target, authtypes.NewModuleAddress(distrtypes.ModuleName))
+	if err != nil {
+		return AllocationRuleReport{}, err
+	}
+
+	coin := sdk.Coin{Denom: denom, Amount: topUp}
+	if err := data.Balances.add(address, rule.Name, coin); err != nil {
+		return AllocationRuleReport{}, err
+	}
+	data.CommunityPool = data.CommunityPool.Add(coin)
+	report.Accounts = 1
+
+	return report, nil
+}
+
+// Write the per-rule summary to allocation_report.json and every changed
+// balance to allocation_changes.csv.
+func writeAllocationReport(reports []AllocationRuleReport, changes []AllocationChange, reportDir string) error {
+	reportJSON, err := json.MarshalIndent(reports, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal allocation report: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(reportDir, "allocation_report.json"), reportJSON, 0o600); err != nil {
+		return fmt.Errorf("failed to write allocation report: %w", err)
+	}
+
+	file, err := os.Create(filepath.Join(reportDir, "allocation_changes.csv"))
+	if err != nil {
+		return fmt.Errorf("failed to create allocation report: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	if err := writer.Write([]string{"rule", "address", "before", "after"}); err != nil {
+		return fmt.Errorf("failed to write allocation report: %w", err)
+	}
+
+	for _, change := range changes {
+		if err := writer.Write([]string{change.Rule, change.Address, change.Before.String(), change.After.String()}); err != nil {
+			return fmt.Errorf("failed to write allocation report: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write allocation report: %w", err)
+	}
+
+	return nil
+}
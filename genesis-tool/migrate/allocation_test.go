@@ -0,0 +1,161 @@
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestApplyAllocationRules(t *testing.T) {
+	t.Parallel()
+
+	const (
+		holder = "unicorn1qqyl24rxge02cgkqnq4p2340s28kdd89zld79f"
+		other  = "unicorn1qqxm5thy3xjwwmz8re26d6kdme9y60jfrzhag3"
+	)
+
+	tests := []struct {
+		name     string
+		rules    string
+		expected map[string]string // Balances after the rules
+		pool     string            // Balance of the community pool
+		// Balance of the old chain's fee collector, redirected to the
+		// community pool before the rules run
+		feeCollector int64
+		err          string
+	}{
+		{
+			name: "bonded multiplier",
+			rules: `rules:
+  - {name: bonus, type: bonded_multiplier, multiplier: "2"}`,
+			expected: map[string]string{holder: "250ugadikian", other: "40ugadikian"},
+		},
+		{
+			// The cap lowers the bonded and liquid parts in proportion, 100
+			// and 50 to 80 and 40, and only what is left of the bond doubles
+			name: "cap before bonded multiplier",
+			rules: `rules:
+  - {name: cap, type: cap, amount: "120"}
+  - {name: bonus, type: bonded_multiplier, multiplier: "2"}`,
+			expected: map[string]string{holder: "200ugadikian", other: "40ugadikian"},
+		},
+		{
+			name: "exclude before bonded multiplier",
+			rules: `rules:
+  - {name: exclusions, type: exclude, addresses: [` + holder + `]}
+  - {name: bonus, type: bonded_multiplier, multiplier: "2"}`,
+			expected: map[string]string{other: "40ugadikian"},
+		},
+		{
+			name: "top up",
+			rules: `rules:
+  - {name: dust, type: min_balance, amount: "50"}
+  - {name: community-pool, type: community_pool_top_up}`,
+			expected: map[string]string{holder: "150ugadikian"},
+			pool:     "40ugadikian",
+		},
+		{
+			// The community pool funded by the redirect is neither capped nor
+			// counted in the top-up
+			name: "cap with module account balance",
+			rules: `rules:
+  - {name: cap, type: cap, amount: "120"}
+  - {name: community-pool, type: community_pool_top_up}`,
+			feeCollector: 500,
+			expected:     map[string]string{holder: "120ugadikian", other: "40ugadikian"},
+			pool:         "530ugadikian",
+		},
+		{
+			name: "top up not last",
+			rules: `rules:
+  - {name: community-pool, type: community_pool_top_up}
+  - {name: dust, type: min_balance, amount: "50"}`,
+			err: "must be the last rule",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// The bond file is mapped with a directory, but credited under its file name
+			dir := t.TempDir()
+			require.NoError(t, os.Mkdir(filepath.Join(dir, "bonds"), 0o700))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "bonds", "kaway_bond.csv"), []byte("address,uwu\n"+holder+",100\n"), 0o600))
+
+			manifest := DefaultManifest()
+			manifest.Input.Dir = dir
+			manifest.Input.KawayBond = "bonds/kaway_bond.csv"
+
+			data := &GenesisData{
+				Manifest:    manifest,
+				Accounts:    make(map[string]uint64),
+				Balances:    newLedger(),
+				StakingMode: StakingModeLiquid,
+				RowErrors:   &RowErrors{},
+			}
+			require.NoError(t, data.Balances.addAmount(holder, "balances.csv", BondDenom, math.NewInt(50)))
+			require.NoError(t, data.Balances.addAmount(other, "balances.csv", BondDenom, math.NewInt(40)))
+			require.NoError(t, processBonds(manifest.Input.path(manifest.Input.KawayBond), BondDenom, data))
+
+			if tc.feeCollector > 0 {
+				feeCollector := sdk.MustBech32ifyAddressBytes(manifest.Prefixes.Source, authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+				require.NoError(t, data.Balances.addAmount(feeCollector, "balances.csv", BondDenom, math.NewInt(tc.feeCollector)))
+			}
+
+			var err error
+			data.Balances, err = data.Balances.convert(manifest)
+			require.NoError(t, err)
+
+			if tc.feeCollector > 0 {
+				data.ModuleAccounts = make(map[string]string)
+				require.NoError(t, redirectModuleAccounts(data, ModuleAccountPolicyCommunityPool, "", t.TempDir()))
+			}
+
+			rulesPath := filepath.Join(dir, "rules.yaml")
+			require.NoError(t, os.WriteFile(rulesPath, []byte(tc.rules), 0o600))
+
+			err = applyAllocationRules(data, rulesPath, t.TempDir())
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			expected := make(map[string]string, len(tc.expected))
+			for address, coins := range tc.expected {
+				converted, err := manifest.convertAddress(address)
+				require.NoError(t, err)
+				expected[converted] = coins
+			}
+			if tc.pool != "" {
+				communityPool, err := manifest.moduleAddress(distrtypes.ModuleName)
+				require.NoError(t, err)
+				expected[communityPool] = tc.pool
+				require.Equal(t, tc.pool, data.CommunityPool.String())
+			}
+			balances := make(map[string]string)
+			for _, address := range data.Balances.addresses() {
+				balances[address] = data.Balances.balance(address).String()
+			}
+			require.Equal(t, expected, balances)
+
+			// The credits of each source still add up to the balances
+			for _, address := range data.Balances.addresses() {
+				total := math.ZeroInt()
+				for _, credited := range data.Balances.bySource[address] {
+					total = total.Add(credited.AmountOf("ugadikian"))
+				}
+				require.Equal(t, data.Balances.balance(address).AmountOf("ugadikian"), total)
+			}
+		})
+	}
+}
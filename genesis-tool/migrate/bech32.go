@@ -0,0 +1,95 @@
+package migrate
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// Convert a source chain address to a target chain address.
+//
+// The address is fully decoded, verifying its checksum, and the address bytes
+// are re-encoded under the target prefix. Any suffix of the human-readable
+// part is kept, so unicornvaloper addresses become gadikianvaloper addresses.
+// Addresses that already carry the target prefix are validated the same way
+// and returned unchanged.
+func (m *Manifest) convertAddress(address string) (string, error) {
+	hrp, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return "", fmt.Errorf("invalid address %q: %w", address, err)
+	}
+
+	if err := sdk.VerifyAddressFormat(bz); err != nil {
+		return "", fmt.Errorf("invalid address %q: %w", address, err)
+	}
+
+	var newHRP string
+	switch {
+	case strings.HasPrefix(hrp, m.Prefixes.Source):
+		newHRP = m.Prefixes.Target + strings.TrimPrefix(hrp, m.Prefixes.Source)
+	case strings.HasPrefix(hrp, m.Prefixes.Target):
+		return address, nil
+	default:
+		return "", fmt.Errorf("invalid address %q: unexpected prefix %q", address, hrp)
+	}
+
+	return bech32.ConvertAndEncode(newHRP, bz)
+}
+
+// Check that an address read from the snapshot can be converted, reporting the
+// file, line and column it was read from otherwise.
+func (m *Manifest) checkAddress(file string, line int, column, address string) *RowError {
+	if _, err := m.convertAddress(address); err != nil {
+		return &RowError{File: file, Row: line, Column: column, Value: address, Reason: err.Error()}
+	}
+
+	return nil
+}
+
+// Check that a denom read from the snapshot can be converted, reporting the
+// file, line and column it was read from otherwise.
+func (m *Manifest) checkDenom(file string, line int, column, denom string) *RowError {
+	if _, err := m.convertDenom(denom); err != nil {
+		return &RowError{File: file, Row: line, Column: column, Value: denom, Reason: err.Error()}
+	}
+
+	return nil
+}
+
+// Convert a denom according to the manifest's rename rules.
+//
+// Token factory denoms have the form factory/{creator}/{subdenom}; their
+// creator address is re-encoded like any other address unless creator
+// rewriting is disabled.
+func (m *Manifest) convertDenom(denom string) (string, error) {
+	for _, rule := range m.Denoms.Rename {
+		if rule.From == denom {
+			return rule.To, nil
+		}
+	}
+
+	if !m.Denoms.RewriteFactoryCreators || !strings.HasPrefix(denom, "factory/") {
+		return denom, nil
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 {
+		return "", fmt.Errorf("invalid factory denom %q: expected factory/{creator}/{subdenom}", denom)
+	}
+
+	creator, err := m.convertAddress(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("invalid factory denom %q: %w", denom, err)
+	}
+
+	return strings.Join([]string{parts[0], creator, parts[2]}, "/"), nil
+}
+
+// Return the line number of the record most recently read by reader.
+func recordLine(reader *csv.Reader) int {
+	line, _ := reader.FieldPos(0)
+	return line
+}
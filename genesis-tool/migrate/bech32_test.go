@@ -0,0 +1,109 @@
+package migrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertAddress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		address string
+		want    string
+		err     string
+	}{
+		{
+			name:    "account",
+			address: "unicorn1qqyl24rxge02cgkqnq4p2340s28kdd89zld79f",
+			want:    "gadikian1qqyl24rxge02cgkqnq4p2340s28kdd899g2kmn",
+		},
+		{
+			name:    "operator",
+			address: "unicornvaloper1qqyl24rxge02cgkqnq4p2340s28kdd8984lnya",
+			want:    "gadikianvaloper1qqyl24rxge02cgkqnq4p2340s28kdd89tjsyks",
+		},
+		{
+			name:    "already converted",
+			address: "gadikian1qqyl24rxge02cgkqnq4p2340s28kdd899g2kmn",
+			want:    "gadikian1qqyl24rxge02cgkqnq4p2340s28kdd899g2kmn",
+		},
+		{
+			name:    "other prefix",
+			address: "cosmos1qqyl24rxge02cgkqnq4p2340s28kdd89ycq0h8",
+			err:     `unexpected prefix "cosmos"`,
+		},
+		{
+			name:    "bad checksum",
+			address: "unicorn1qqyl24rxge02cgkqnq4p2340s28kdd89zld79g",
+			err:     "invalid address",
+		},
+		{
+			name:    "empty",
+			address: "",
+			err:     "invalid address",
+		},
+	}
+
+	m := DefaultManifest()
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := m.convertAddress(tc.address)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestConvertDenom(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		denom   string
+		rewrite bool
+		want    string
+		err     string
+	}{
+		{name: "bond denom", denom: "uwunicorn", rewrite: true, want: "ugadikian"},
+		{name: "unrenamed denom", denom: "uatom", rewrite: true, want: "uatom"},
+		{
+			name:    "factory denom",
+			denom:   "factory/unicorn1rn9f6ack3u8t3ed04pfaqpmh5zfp2m2ll4mkty/ubear",
+			rewrite: true,
+			want:    "factory/gadikian1rn9f6ack3u8t3ed04pfaqpmh5zfp2m2lczu747/ubear",
+		},
+		{
+			name:  "factory denom kept",
+			denom: "factory/unicorn1rn9f6ack3u8t3ed04pfaqpmh5zfp2m2ll4mkty/ubear",
+			want:  "factory/unicorn1rn9f6ack3u8t3ed04pfaqpmh5zfp2m2ll4mkty/ubear",
+		},
+		{name: "factory denom without subdenom", denom: "factory/unicorn1rn9f6ack3u8t3ed04pfaqpmh5zfp2m2ll4mkty", rewrite: true, err: "expected factory/{creator}/{subdenom}"},
+		{name: "factory denom with bad creator", denom: "factory/nobody/ubear", rewrite: true, err: "invalid factory denom"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := DefaultManifest()
+			m.Denoms.RewriteFactoryCreators = tc.rewrite
+
+			got, err := m.convertDenom(tc.denom)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
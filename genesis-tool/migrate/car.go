@@ -0,0 +1,675 @@
+package migrate
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Multicodec and multihash codes used by IPFS snapshots.
+const (
+	codecRaw     = 0x55
+	codecDagPB   = 0x70
+	hashSHA256   = 0x12
+	hashIdentity = 0x00
+)
+
+// UnixFS node types.
+const (
+	unixfsRaw       = 0
+	unixfsDirectory = 1
+	unixfsFile      = 2
+)
+
+// carV2Pragma is the start of every CARv2 file: a CARv1 header of version 2.
+var carV2Pragma = []byte{0x0a, 0xa1, 0x67, 'v', 'e', 'r', 's', 'i', 'o', 'n', 0x02}
+
+// maxCARSection bounds the size of a single CAR section, so a corrupt length
+// cannot make the reader allocate arbitrary memory.
+const maxCARSection = 32 << 20
+
+// CAR is the content of a CAR archive: its root CIDs and every block, each
+// verified against the hash in its CID.
+type CAR struct {
+	Roots  [][]byte
+	Blocks map[string][]byte // Keyed by binary CID
+}
+
+// Unpack the snapshot in a CAR archive into destDir.
+//
+// The archive's root must be expectedCID, and every block is checked against
+// its CID as it is read, so the unpacked files are exactly the content that
+// expectedCID names. The root must be a UnixFS directory.
+func unpackCAR(carPath, expectedCID, destDir string) error {
+	expected, err := parseCID(expectedCID)
+	if err != nil {
+		return fmt.Errorf("invalid snapshot CID: %w", err)
+	}
+
+	car, err := readCARFile(carPath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", filepath.Base(carPath), err)
+	}
+
+	if len(car.Roots) != 1 {
+		return fmt.Errorf("%s has %d roots, expected 1", filepath.Base(carPath), len(car.Roots))
+	}
+	if !bytes.Equal(car.Roots[0], expected) {
+		return fmt.Errorf("%s has root %s, expected %s", filepath.Base(carPath), formatCID(car.Roots[0]), expectedCID)
+	}
+
+	node, err := car.unixfsNode(expected)
+	if err != nil {
+		return err
+	}
+	if node.Type != unixfsDirectory {
+		return fmt.Errorf("root %s is not a directory", expectedCID)
+	}
+
+	return car.unpackDirectory(node, destDir)
+}
+
+// Read a CARv1 or CARv2 file.
+func readCARFile(path string) (*CAR, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	pragma := make([]byte, len(carV2Pragma))
+	if _, err := io.ReadFull(file, pragma); err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	if !bytes.Equal(pragma, carV2Pragma) {
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		return readCAR(file)
+	}
+
+	// A CARv2 header wraps a CARv1 payload at a known offset
+	header := make([]byte, 40)
+	if _, err := io.ReadFull(file, header); err != nil {
+		return nil, fmt.Errorf("failed to read CARv2 header: %w", err)
+	}
+	dataOffset := binary.LittleEndian.Uint64(header[16:24])
+	dataSize := binary.LittleEndian.Uint64(header[24:32])
+	if dataOffset > 1<<62 || dataSize > 1<<62 {
+		return nil, errors.New("invalid CARv2 header")
+	}
+
+	return readCAR(io.NewSectionReader(file, int64(dataOffset), int64(dataSize)))
+}
+
+// Read a CARv1 stream, verifying every block.
+func readCAR(r io.Reader) (*CAR, error) {
+	reader := bufio.NewReader(r)
+
+	header, err := readCARSection(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	roots, err := parseCARHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	car := &CAR{Roots: roots, Blocks: make(map[string][]byte)}
+	for {
+		section, err := readCARSection(reader)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read block: %w", err)
+		}
+
+		cid, data, err := splitCID(section)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := verifyBlock(cid, data); err != nil {
+			return nil, err
+		}
+		car.Blocks[string(cid)] = data
+	}
+
+	return car, nil
+}
+
+// Read a varint length-prefixed section, returning io.EOF at the end of the stream.
+func readCARSection(reader *bufio.Reader) ([]byte, error) {
+	length, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return nil, err
+	}
+	if length == 0 || length > maxCARSection {
+		return nil, fmt.Errorf("invalid section length %d", length)
+	}
+
+	section := make([]byte, length)
+	if _, err := io.ReadFull(reader, section); err != nil {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return section, nil
+}
+
+// Parse a CARv1 header, the DAG-CBOR map {"roots": [CID...], "version": 1}.
+func parseCARHeader(header []byte) ([][]byte, error) {
+	decoder := &cborDecoder{data: header}
+	value, err := decoder.decode()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CAR header: %w", err)
+	}
+
+	fields, ok := value.(map[string]any)
+	if !ok {
+		return nil, errors.New("invalid CAR header: not a map")
+	}
+	if version, ok := fields["version"].(uint64); !ok || version != 1 {
+		return nil, fmt.Errorf("unsupported CAR version %v", fields["version"])
+	}
+
+	list, ok := fields["roots"].([]any)
+	if !ok {
+		return nil, errors.New("invalid CAR header: roots is not a list")
+	}
+
+	roots := make([][]byte, 0, len(list))
+	for _, root := range list {
+		link, ok := root.(cborLink)
+		if !ok {
+			return nil, errors.New("invalid CAR header: root is not a CID")
+		}
+		roots = append(roots, link)
+	}
+
+	return roots, nil
+}
+
+// Split a CAR section into the binary CID and the block data that follows it.
+func splitCID(section []byte) ([]byte, []byte, error) {
+	// A CIDv0 is a bare sha2-256 multihash
+	if len(section) >= 34 && section[0] == hashSHA256 && section[1] == 32 {
+		return section[:34], section[34:], nil
+	}
+
+	reader := bytes.NewReader(section)
+	for i := 0; i < 4; i++ { // Version, codec, hash code and digest length
+		value, err := binary.ReadUvarint(reader)
+		if err != nil {
+			return nil, nil, errors.New("invalid CID")
+		}
+		if i == 0 && value != 1 {
+			return nil, nil, fmt.Errorf("unsupported CID version %d", value)
+		}
+		if i == 3 {
+			if value > uint64(reader.Len()) {
+				return nil, nil, errors.New("invalid CID")
+			}
+			if _, err := reader.Seek(int64(value), io.SeekCurrent); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	length := len(section) - reader.Len()
+
+	return section[:length], section[length:], nil
+}
+
+// Return the codec of a binary CID, and its multihash code and digest.
+func decodeCID(cid []byte) (codec, hashCode uint64, digest []byte, err error) {
+	if len(cid) == 34 && cid[0] == hashSHA256 && cid[1] == 32 {
+		return codecDagPB, hashSHA256, cid[2:], nil
+	}
+
+	reader := bytes.NewReader(cid)
+	values := make([]uint64, 4) // Version, codec, hash code and digest length
+	for i := range values {
+		if values[i], err = binary.ReadUvarint(reader); err != nil {
+			return 0, 0, nil, errors.New("invalid CID")
+		}
+	}
+	if values[0] != 1 || values[3] != uint64(reader.Len()) {
+		return 0, 0, nil, errors.New("invalid CID")
+	}
+
+	return values[1], values[2], cid[len(cid)-reader.Len():], nil
+}
+
+// Check that data hashes to the digest in its CID.
+func verifyBlock(cid, data []byte) error {
+	_, hashCode, digest, err := decodeCID(cid)
+	if err != nil {
+		return err
+	}
+
+	var sum []byte
+	switch hashCode {
+	case hashSHA256:
+		hash := sha256.Sum256(data)
+		sum = hash[:]
+	case hashIdentity:
+		sum = data
+	default:
+		return fmt.Errorf("block %s uses unsupported hash function 0x%x", formatCID(cid), hashCode)
+	}
+
+	if !bytes.Equal(sum, digest) {
+		return fmt.Errorf("block %s does not match its CID", formatCID(cid))
+	}
+
+	return nil
+}
+
+// UnixFSNode is a decoded UnixFS node.
+type UnixFSNode struct {
+	Type  uint64
+	Data  []byte
+	Links []PBLink // Directory entries or file chunks, in order
+}
+
+// PBLink is a link of a dag-pb node.
+type PBLink struct {
+	Hash []byte
+	Name string
+}
+
+// Decode the UnixFS node stored under cid. Raw blocks are file data.
+func (c *CAR) unixfsNode(cid []byte) (*UnixFSNode, error) {
+	block, ok := c.Blocks[string(cid)]
+	if !ok {
+		return nil, fmt.Errorf("block %s is missing from the archive", formatCID(cid))
+	}
+
+	codec, _, _, err := decodeCID(cid)
+	if err != nil {
+		return nil, err
+	}
+
+	switch codec {
+	case codecRaw:
+		return &UnixFSNode{Type: unixfsRaw, Data: block}, nil
+	case codecDagPB:
+		node, err := decodeDagPB(block)
+		if err != nil {
+			return nil, fmt.Errorf("invalid block %s: %w", formatCID(cid), err)
+		}
+		return node, nil
+	default:
+		return nil, fmt.Errorf("block %s uses unsupported codec 0x%x", formatCID(cid), codec)
+	}
+}
+
+// Write every entry of a UnixFS directory into dir.
+func (c *CAR) unpackDirectory(node *UnixFSNode, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	for _, link := range node.Links {
+		if link.Name == "" || link.Name == "." || link.Name == ".." || strings.ContainsAny(link.Name, `/\`) {
+			return fmt.Errorf("invalid directory entry name %q", link.Name)
+		}
+
+		child, err := c.unixfsNode(link.Hash)
+		if err != nil {
+			return err
+		}
+
+		path := filepath.Join(dir, link.Name)
+		if child.Type == unixfsDirectory {
+			if err := c.unpackDirectory(child, path); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := c.unpackFile(child, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Write a UnixFS file to path.
+func (c *CAR) unpackFile(node *UnixFSNode, path string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	if err := c.writeFile(node, writer); err != nil {
+		return fmt.Errorf("failed to unpack %s: %w", filepath.Base(path), err)
+	}
+
+	return writer.Flush()
+}
+
+// Write the content of a UnixFS file node: its own data, then its chunks in order.
+func (c *CAR) writeFile(node *UnixFSNode, w io.Writer) error {
+	if node.Type != unixfsFile && node.Type != unixfsRaw {
+		return fmt.Errorf("unsupported UnixFS node type %d", node.Type)
+	}
+
+	if _, err := w.Write(node.Data); err != nil {
+		return err
+	}
+
+	for _, link := range node.Links {
+		child, err := c.unixfsNode(link.Hash)
+		if err != nil {
+			return err
+		}
+		if err := c.writeFile(child, w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Decode a dag-pb block and the UnixFS data it carries.
+func decodeDagPB(block []byte) (*UnixFSNode, error) {
+	node := &UnixFSNode{}
+
+	var unixfsData []byte
+	err := readProtobuf(block, func(field uint64, value []byte, _ uint64) error {
+		switch field {
+		case 1:
+			unixfsData = value
+		case 2:
+			var link PBLink
+			err := readProtobuf(value, func(field uint64, value []byte, _ uint64) error {
+				switch field {
+				case 1:
+					link.Hash = value
+				case 2:
+					link.Name = string(value)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			node.Links = append(node.Links, link)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if unixfsData == nil {
+		return nil, errors.New("dag-pb node has no UnixFS data")
+	}
+
+	err = readProtobuf(unixfsData, func(field uint64, value []byte, varint uint64) error {
+		switch field {
+		case 1:
+			node.Type = varint
+		case 2:
+			node.Data = value
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+// Call fn for every field of a protobuf message, with the bytes of
+// length-delimited fields or the value of varint fields.
+func readProtobuf(message []byte, fn func(field uint64, value []byte, varint uint64) error) error {
+	reader := bytes.NewReader(message)
+	for reader.Len() > 0 {
+		key, err := binary.ReadUvarint(reader)
+		if err != nil {
+			return errors.New("invalid protobuf field")
+		}
+
+		field, wireType := key>>3, key&7
+		switch wireType {
+		case 0:
+			value, err := binary.ReadUvarint(reader)
+			if err != nil {
+				return errors.New("invalid protobuf varint")
+			}
+			if err := fn(field, nil, value); err != nil {
+				return err
+			}
+		case 2:
+			length, err := binary.ReadUvarint(reader)
+			if err != nil || length > uint64(reader.Len()) {
+				return errors.New("invalid protobuf length")
+			}
+			offset := len(message) - reader.Len()
+			value := message[offset : offset+int(length)]
+			if _, err := reader.Seek(int64(length), io.SeekCurrent); err != nil {
+				return err
+			}
+			if err := fn(field, value, 0); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported protobuf wire type %d", wireType)
+		}
+	}
+
+	return nil
+}
+
+// base58btc is the alphabet of CIDv0 strings.
+const base58btc = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// cidBase32 is the multibase base32 encoding of CIDv1 strings.
+var cidBase32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Parse a CID string: a base58btc CIDv0 (Qm...) or a base32 CIDv1 (b...).
+func parseCID(s string) ([]byte, error) {
+	switch {
+	case strings.HasPrefix(s, "Qm") && len(s) == 46:
+		bz, err := decodeBase58(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CID %q: %w", s, err)
+		}
+		if len(bz) != 34 || bz[0] != hashSHA256 || bz[1] != 32 {
+			return nil, fmt.Errorf("invalid CID %q", s)
+		}
+		return bz, nil
+	case strings.HasPrefix(s, "b"):
+		bz, err := cidBase32.DecodeString(strings.ToUpper(s[1:]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid CID %q: %w", s, err)
+		}
+		if _, _, _, err := decodeCID(bz); err != nil {
+			return nil, fmt.Errorf("invalid CID %q: %w", s, err)
+		}
+		return bz, nil
+	default:
+		return nil, fmt.Errorf("invalid CID %q: expected a Qm... CIDv0 or a base32 b... CIDv1", s)
+	}
+}
+
+// Format a binary CID the way IPFS displays it.
+func formatCID(cid []byte) string {
+	if len(cid) == 34 && cid[0] == hashSHA256 && cid[1] == 32 {
+		return encodeBase58(cid)
+	}
+
+	return "b" + strings.ToLower(cidBase32.EncodeToString(cid))
+}
+
+// Decode a base58btc string.
+func decodeBase58(s string) ([]byte, error) {
+	value := new(big.Int)
+	for _, c := range s {
+		digit := strings.IndexRune(base58btc, c)
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", c)
+		}
+		value.Mul(value, big.NewInt(58))
+		value.Add(value, big.NewInt(int64(digit)))
+	}
+
+	// Leading 1s encode leading zero bytes
+	zeros := len(s) - len(strings.TrimLeft(s, "1"))
+
+	return append(make([]byte, zeros), value.Bytes()...), nil
+}
+
+// Encode bytes as base58btc.
+func encodeBase58(bz []byte) string {
+	value := new(big.Int).SetBytes(bz)
+	base := big.NewInt(58)
+	mod := new(big.Int)
+
+	var encoded []byte
+	for value.Sign() > 0 {
+		value.DivMod(value, base, mod)
+		encoded = append(encoded, base58btc[mod.Int64()])
+	}
+	for _, b := range bz {
+		if b != 0 {
+			break
+		}
+		encoded = append(encoded, '1')
+	}
+
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+
+	return string(encoded)
+}
+
+// cborLink is a CID decoded from DAG-CBOR tag 42.
+type cborLink []byte
+
+// cborDecoder decodes the subset of DAG-CBOR used by CAR headers: maps, lists,
+// strings, byte strings, unsigned integers and CID links.
+type cborDecoder struct {
+	data []byte
+	pos  int
+}
+
+// Decode the next value.
+func (d *cborDecoder) decode() (any, error) {
+	major, arg, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+
+	switch major {
+	case 0:
+		return arg, nil
+	case 2, 3:
+		bz, err := d.bytes(arg)
+		if err != nil {
+			return nil, err
+		}
+		if major == 3 {
+			return string(bz), nil
+		}
+		return bz, nil
+	case 4:
+		list := make([]any, 0, min(arg, 16))
+		for i := uint64(0); i < arg; i++ {
+			value, err := d.decode()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		return list, nil
+	case 5:
+		fields := make(map[string]any, min(arg, 16))
+		for i := uint64(0); i < arg; i++ {
+			key, err := d.decode()
+			if err != nil {
+				return nil, err
+			}
+			name, ok := key.(string)
+			if !ok {
+				return nil, errors.New("map key is not a string")
+			}
+			if fields[name], err = d.decode(); err != nil {
+				return nil, err
+			}
+		}
+		return fields, nil
+	case 6:
+		value, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+		// CIDs are byte strings with a leading multibase identity prefix
+		bz, ok := value.([]byte)
+		if arg != 42 || !ok || len(bz) < 2 || bz[0] != 0 {
+			return nil, fmt.Errorf("unsupported tag %d", arg)
+		}
+		return cborLink(bz[1:]), nil
+	default:
+		return nil, fmt.Errorf("unsupported major type %d", major)
+	}
+}
+
+// Read the major type and argument of the next item.
+func (d *cborDecoder) head() (major byte, arg uint64, err error) {
+	if d.pos >= len(d.data) {
+		return 0, 0, io.ErrUnexpectedEOF
+	}
+
+	initial := d.data[d.pos]
+	d.pos++
+	major, info := initial>>5, initial&0x1f
+
+	switch {
+	case info < 24:
+		return major, uint64(info), nil
+	case info <= 27:
+		size := 1 << (info - 24)
+		bz, err := d.bytes(uint64(size))
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, b := range bz {
+			arg = arg<<8 | uint64(b)
+		}
+		return major, arg, nil
+	default:
+		return 0, 0, errors.New("indefinite lengths are not supported")
+	}
+}
+
+// Read the next n bytes.
+func (d *cborDecoder) bytes(n uint64) ([]byte, error) {
+	if n > uint64(len(d.data)-d.pos) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	bz := d.data[d.pos : d.pos+int(n)]
+	d.pos += int(n)
+
+	return bz, nil
+}
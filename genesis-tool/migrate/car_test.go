@@ -0,0 +1,93 @@
+package migrate
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The fixture holds a snapshot directory with supply.csv, kaway_bond.csv split
+// into two raw leaves, and notes/README in a subdirectory.
+const (
+	fixtureCAR = "testdata/QmTeomxZfGz2eeUA9PE9uEFFpiFqUbnx4g5RTXbbsqUYLT.car"
+	fixtureCID = "QmTeomxZfGz2eeUA9PE9uEFFpiFqUbnx4g5RTXbbsqUYLT"
+)
+
+func TestUnpackCAR(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+
+	require.NoError(t, unpackCAR(fixtureCAR, fixtureCID, dir))
+
+	supply, err := os.ReadFile(filepath.Join(dir, "supply.csv"))
+	require.NoError(t, err)
+	require.Equal(t, "denom,amount\nuwunicorn,1000000\n", string(supply))
+
+	bonds, err := os.ReadFile(filepath.Join(dir, "kaway_bond.csv"))
+	require.NoError(t, err)
+	require.Equal(t, "address,uwu\n"+
+		"unicorn1qqyl24rxge02cgkqnq4p2340s28kdd89zld79f,22900000000\n"+
+		"unicorn1qqyl24rxge02cgkqnq4p2340s28kdd89zld79f,0\n", string(bonds))
+
+	readme, err := os.ReadFile(filepath.Join(dir, "notes", "README"))
+	require.NoError(t, err)
+	require.Equal(t, "Fixture snapshot for the CAR reader tests.\n", string(readme))
+}
+
+func TestUnpackCARRootMismatch(t *testing.T) {
+	t.Parallel()
+
+	// The well-known CID of an empty directory
+	err := unpackCAR(fixtureCAR, "QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn", t.TempDir())
+	require.ErrorContains(t, err, "expected QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn")
+}
+
+func TestUnpackCARCorruptBlock(t *testing.T) {
+	t.Parallel()
+
+	bz, err := os.ReadFile(fixtureCAR)
+	require.NoError(t, err)
+
+	// Change a byte of supply.csv without touching the CIDs
+	i := bytes.Index(bz, []byte("1000000"))
+	require.Positive(t, i)
+	bz[i] = '9'
+
+	corrupt := filepath.Join(t.TempDir(), "corrupt.car")
+	require.NoError(t, os.WriteFile(corrupt, bz, 0o600))
+
+	err = unpackCAR(corrupt, fixtureCID, t.TempDir())
+	require.ErrorContains(t, err, "does not match its CID")
+}
+
+func TestParseCID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cid  string
+		err  bool
+	}{
+		{"cidv0", fixtureCID, false},
+		{"cidv1", "bafkreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku", false},
+		{"bad base58", "Qm0000000000000000000000000000000000000000000", true},
+		{"unknown multibase", "zb2rhe5P4gXftAwvA4eXQ5HJwsER2owDyS9sKaQRRVQPn93bA", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cid, err := parseCID(tc.cid)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.cid, formatCID(cid))
+		})
+	}
+}
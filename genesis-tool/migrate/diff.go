@@ -0,0 +1,409 @@
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// GenesisDiff is what changed from an old genesis to a new one.
+//
+// Genesis files are compared as JSON, without the app's codec, so genesis
+// files of chains with any address prefix can be compared.
+type GenesisDiff struct {
+	AddedAccounts   []AccountEntry `json:"added_accounts"`
+	RemovedAccounts []AccountEntry `json:"removed_accounts"`
+	Balances        []AmountChange `json:"balances"`
+	Supply          []AmountChange `json:"supply"`
+	Params          []ParamChange  `json:"params"`
+}
+
+// AccountEntry is an account in the auth genesis.
+type AccountEntry struct {
+	Address string `json:"address"`
+	Type    string `json:"type"` // The account's @type
+}
+
+// AmountChange is the change of a single denom, in the balance of an address
+// or in the supply.
+type AmountChange struct {
+	Address string `json:"address,omitempty"` // Empty for the supply
+	Denom   string `json:"denom"`
+	Old     string `json:"old"`
+	New     string `json:"new"`
+	// Delta is new minus old; positive means the amount grew.
+	Delta string `json:"delta"`
+}
+
+// ParamChange is the change of a single module or consensus param, with its
+// old and new values as JSON. A param missing on one side has an empty value.
+type ParamChange struct {
+	Path string `json:"path"` // e.g. staking.params.unbonding_time
+	Old  string `json:"old"`
+	New  string `json:"new"`
+}
+
+// Empty reports whether the genesis files are the same in every compared part.
+func (d *GenesisDiff) Empty() bool {
+	return len(d.AddedAccounts) == 0 && len(d.RemovedAccounts) == 0 &&
+		len(d.Balances) == 0 && len(d.Supply) == 0 && len(d.Params) == 0
+}
+
+// DiffGenesis compares the accounts, balances, supply and params of two
+// genesis files. Every list in the result is sorted, by address and denom or
+// by param path.
+func DiffGenesis(oldGenesis, newGenesis *genutiltypes.AppGenesis) (*GenesisDiff, error) {
+	oldState, err := readDiffState(oldGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("old genesis: %w", err)
+	}
+
+	newState, err := readDiffState(newGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("new genesis: %w", err)
+	}
+
+	diff := &GenesisDiff{
+		AddedAccounts:   make([]AccountEntry, 0),
+		RemovedAccounts: make([]AccountEntry, 0),
+		Balances:        make([]AmountChange, 0),
+		Supply:          make([]AmountChange, 0),
+		Params:          make([]ParamChange, 0),
+	}
+
+	for _, address := range unionKeys(oldState.accounts, newState.accounts) {
+		oldType, inOld := oldState.accounts[address]
+		newType, inNew := newState.accounts[address]
+		switch {
+		case !inOld:
+			diff.AddedAccounts = append(diff.AddedAccounts, AccountEntry{Address: address, Type: newType})
+		case !inNew:
+			diff.RemovedAccounts = append(diff.RemovedAccounts, AccountEntry{Address: address, Type: oldType})
+		}
+	}
+
+	for _, address := range unionKeys(oldState.balances, newState.balances) {
+		diff.Balances = append(diff.Balances, diffAmounts(address, oldState.balances[address], newState.balances[address])...)
+	}
+
+	diff.Supply = append(diff.Supply, diffAmounts("", oldState.supply, newState.supply)...)
+
+	for _, path := range unionKeys(oldState.params, newState.params) {
+		if oldState.params[path] != newState.params[path] {
+			diff.Params = append(diff.Params, ParamChange{Path: path, Old: oldState.params[path], New: newState.params[path]})
+		}
+	}
+
+	return diff, nil
+}
+
+// LoadGenesis returns the genesis at path, for comparing with DiffGenesis.
+//
+// A path ending in .json is read as a genesis file. Anything else is a
+// snapshot, a directory or a CAR archive, which is migrated with manifest and
+// opts reading it instead of the manifest's input, and built in memory. The
+// genesis time is not compared, so a snapshot's manifest need not set one.
+func LoadGenesis(path string, manifest *Manifest, opts Options) (*genutiltypes.AppGenesis, error) {
+	if strings.HasSuffix(path, ".json") {
+		appGenesis, err := genutiltypes.AppGenesisFromFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read genesis %s: %w", path, err)
+		}
+		return appGenesis, nil
+	}
+
+	if opts.Stream {
+		return nil, errors.New("a streamed migration cannot be compared")
+	}
+
+	snapshot := *manifest
+	snapshot.Input.Dir = ""
+	snapshot.Input.CAR = ""
+	snapshot.Input.CID = ""
+	if strings.HasSuffix(path, ".car") {
+		snapshot.Input.CAR = path
+	} else {
+		snapshot.Input.Dir = path
+	}
+	if snapshot.GenesisTime == "" {
+		snapshot.GenesisTime = time.Unix(0, 0).UTC().Format(time.RFC3339)
+	}
+
+	data, err := LoadSnapshot(&snapshot, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load snapshot %s: %w", path, err)
+	}
+
+	if err := Convert(data); err != nil {
+		return nil, fmt.Errorf("failed to convert snapshot %s: %w", path, err)
+	}
+
+	return Build(data)
+}
+
+// WriteText writes the diff for reading in a terminal.
+func (d *GenesisDiff) WriteText(w io.Writer) error {
+	var buf bytes.Buffer
+
+	if d.Empty() {
+		buf.WriteString("No differences\n")
+		_, err := buf.WriteTo(w)
+		return err
+	}
+
+	fmt.Fprintf(&buf, "Accounts: %d added, %d removed\n", len(d.AddedAccounts), len(d.RemovedAccounts))
+	for _, account := range d.AddedAccounts {
+		fmt.Fprintf(&buf, "  + %s (%s)\n", account.Address, account.Type)
+	}
+	for _, account := range d.RemovedAccounts {
+		fmt.Fprintf(&buf, "  - %s (%s)\n", account.Address, account.Type)
+	}
+
+	fmt.Fprintf(&buf, "Balances: %d changed\n", len(d.Balances))
+	for _, change := range d.Balances {
+		fmt.Fprintf(&buf, "  %s %s: %s -> %s (%s)\n", change.Address, change.Denom, change.Old, change.New, change.Delta)
+	}
+
+	fmt.Fprintf(&buf, "Supply: %d changed\n", len(d.Supply))
+	for _, change := range d.Supply {
+		fmt.Fprintf(&buf, "  %s: %s -> %s (%s)\n", change.Denom, change.Old, change.New, change.Delta)
+	}
+
+	fmt.Fprintf(&buf, "Params: %d changed\n", len(d.Params))
+	for _, change := range d.Params {
+		fmt.Fprintf(&buf, "  %s: %s -> %s\n", change.Path, orNone(change.Old), orNone(change.New))
+	}
+
+	_, err := buf.WriteTo(w)
+
+	return err
+}
+
+// The parts of a genesis that are compared.
+type diffState struct {
+	accounts map[string]string              // Account types keyed by address
+	balances map[string]map[string]*big.Int // Keyed by address, then by denom
+	supply   map[string]*big.Int            // Keyed by denom
+	params   map[string]string              // JSON values keyed by param path
+}
+
+// JSON of a coin in the bank genesis.
+type diffCoin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+// Read the compared parts of a genesis from its JSON.
+func readDiffState(appGenesis *genutiltypes.AppGenesis) (*diffState, error) {
+	state := &diffState{
+		accounts: make(map[string]string),
+		balances: make(map[string]map[string]*big.Int),
+		supply:   make(map[string]*big.Int),
+		params:   make(map[string]string),
+	}
+
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+		return nil, fmt.Errorf("invalid app_state: %w", err)
+	}
+
+	var authGenesis struct {
+		Accounts []map[string]any `json:"accounts"`
+	}
+	if raw, ok := appState["auth"]; ok {
+		if err := json.Unmarshal(raw, &authGenesis); err != nil {
+			return nil, fmt.Errorf("invalid auth genesis: %w", err)
+		}
+	}
+	for i, account := range authGenesis.Accounts {
+		address, ok := accountAddress(account)
+		if !ok {
+			return nil, fmt.Errorf("auth account %d has no address", i)
+		}
+		accountType, _ := account["@type"].(string)
+		state.accounts[address] = accountType
+	}
+
+	var bankGenesis struct {
+		Balances []struct {
+			Address string     `json:"address"`
+			Coins   []diffCoin `json:"coins"`
+		} `json:"balances"`
+		Supply []diffCoin `json:"supply"`
+	}
+	if raw, ok := appState["bank"]; ok {
+		if err := json.Unmarshal(raw, &bankGenesis); err != nil {
+			return nil, fmt.Errorf("invalid bank genesis: %w", err)
+		}
+	}
+	for _, balance := range bankGenesis.Balances {
+		coins, err := diffAmountsOf(balance.Coins)
+		if err != nil {
+			return nil, fmt.Errorf("invalid balance of %s: %w", balance.Address, err)
+		}
+		state.balances[balance.Address] = coins
+	}
+	supply, err := diffAmountsOf(bankGenesis.Supply)
+	if err != nil {
+		return nil, fmt.Errorf("invalid supply: %w", err)
+	}
+	state.supply = supply
+
+	// Module params, in module order
+	modules := make([]string, 0, len(appState))
+	for module := range appState {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	for _, module := range modules {
+		var moduleGenesis map[string]json.RawMessage
+		if err := json.Unmarshal(appState[module], &moduleGenesis); err != nil {
+			// Not an object, so there are no params to compare
+			continue
+		}
+		if params, ok := moduleGenesis["params"]; ok {
+			if err := flattenParams(module+".params", params, state.params); err != nil {
+				return nil, fmt.Errorf("invalid %s params: %w", module, err)
+			}
+		}
+	}
+
+	if appGenesis.Consensus != nil && appGenesis.Consensus.Params != nil {
+		params, err := json.Marshal(appGenesis.Consensus.Params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal consensus params: %w", err)
+		}
+		if err := flattenParams("consensus.params", params, state.params); err != nil {
+			return nil, fmt.Errorf("invalid consensus params: %w", err)
+		}
+	}
+
+	return state, nil
+}
+
+// Find the address of an account, which vesting and module accounts nest in
+// their base account. Nearer addresses win over nested ones.
+func accountAddress(account map[string]any) (string, bool) {
+	if address, ok := account["address"].(string); ok {
+		return address, true
+	}
+
+	keys := make([]string, 0, len(account))
+	for key := range account {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if nested, ok := account[key].(map[string]any); ok {
+			if address, ok := accountAddress(nested); ok {
+				return address, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// Sum a list of genesis coins by denom.
+func diffAmountsOf(coins []diffCoin) (map[string]*big.Int, error) {
+	amounts := make(map[string]*big.Int, len(coins))
+	for _, coin := range coins {
+		amount, ok := new(big.Int).SetString(coin.Amount, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %q for %s", coin.Amount, coin.Denom)
+		}
+		addAmount(amounts, coin.Denom, amount)
+	}
+
+	return amounts, nil
+}
+
+// Return the change of every denom whose amount differs, in denom order.
+func diffAmounts(address string, oldAmounts, newAmounts map[string]*big.Int) []AmountChange {
+	var changes []AmountChange
+	for _, denom := range unionKeys(oldAmounts, newAmounts) {
+		oldAmount := amountOrZero(oldAmounts, denom)
+		newAmount := amountOrZero(newAmounts, denom)
+		delta := new(big.Int).Sub(newAmount, oldAmount)
+		if delta.Sign() == 0 {
+			continue
+		}
+
+		formatted := delta.String()
+		if delta.Sign() > 0 {
+			formatted = "+" + formatted
+		}
+
+		changes = append(changes, AmountChange{
+			Address: address,
+			Denom:   denom,
+			Old:     oldAmount.String(),
+			New:     newAmount.String(),
+			Delta:   formatted,
+		})
+	}
+
+	return changes
+}
+
+// Record every leaf value of the params JSON under its dotted path. Arrays
+// are leaves, so a changed list is shown whole.
+func flattenParams(path string, raw json.RawMessage, params map[string]string) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil || fields == nil {
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, raw); err != nil {
+			return err
+		}
+		params[path] = compact.String()
+		return nil
+	}
+
+	if len(fields) == 0 {
+		params[path] = "{}"
+		return nil
+	}
+
+	for key, value := range fields {
+		if err := flattenParams(path+"."+key, value, params); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Return the keys in either map, sorted.
+func unionKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Show a missing param value.
+func orNone(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+
+	return value
+}
@@ -0,0 +1,85 @@
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const oldDiffAppState = `{
+  "auth": {
+    "params": {"max_memo_characters": "256"},
+    "accounts": [
+      {"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "gadikian1a", "account_number": "0"},
+      {"@type": "/cosmos.vesting.v1beta1.DelayedVestingAccount", "base_vesting_account": {"base_account": {"address": "gadikian1b"}}}
+    ]
+  },
+  "bank": {
+    "balances": [
+      {"address": "gadikian1a", "coins": [{"denom": "ugadikian", "amount": "100"}]},
+      {"address": "gadikian1b", "coins": [{"denom": "ubear", "amount": "5"}, {"denom": "ugadikian", "amount": "50"}]}
+    ],
+    "supply": [{"denom": "ubear", "amount": "5"}, {"denom": "ugadikian", "amount": "150"}]
+  },
+  "staking": {"params": {"unbonding_time": "1814400s", "bond_denom": "ugadikian"}},
+  "genutil": {"gen_txs": []}
+}`
+
+const newDiffAppState = `{
+  "auth": {
+    "params": {"max_memo_characters": "256"},
+    "accounts": [
+      {"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "gadikian1a", "account_number": "0"},
+      {"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "gadikian1c", "account_number": "1"}
+    ]
+  },
+  "bank": {
+    "balances": [
+      {"address": "gadikian1a", "coins": [{"denom": "ugadikian", "amount": "80"}]},
+      {"address": "gadikian1c", "coins": [{"denom": "ugadikian", "amount": "70"}]}
+    ],
+    "supply": [{"denom": "ugadikian", "amount": "150"}]
+  },
+  "staking": {"params": {"unbonding_time": "1209600s", "bond_denom": "ugadikian"}},
+  "genutil": {"gen_txs": []}
+}`
+
+func TestDiffGenesis(t *testing.T) {
+	t.Parallel()
+
+	oldGenesis := &genutiltypes.AppGenesis{AppState: json.RawMessage(oldDiffAppState)}
+	newGenesis := &genutiltypes.AppGenesis{AppState: json.RawMessage(newDiffAppState)}
+
+	diff, err := DiffGenesis(oldGenesis, newGenesis)
+	require.NoError(t, err)
+
+	require.Equal(t, []AccountEntry{{Address: "gadikian1c", Type: "/cosmos.auth.v1beta1.BaseAccount"}}, diff.AddedAccounts)
+	require.Equal(t, []AccountEntry{{Address: "gadikian1b", Type: "/cosmos.vesting.v1beta1.DelayedVestingAccount"}}, diff.RemovedAccounts)
+	require.Equal(t, []AmountChange{
+		{Address: "gadikian1a", Denom: "ugadikian", Old: "100", New: "80", Delta: "-20"},
+		{Address: "gadikian1b", Denom: "ubear", Old: "5", New: "0", Delta: "-5"},
+		{Address: "gadikian1b", Denom: "ugadikian", Old: "50", New: "0", Delta: "-50"},
+		{Address: "gadikian1c", Denom: "ugadikian", Old: "0", New: "70", Delta: "+70"},
+	}, diff.Balances)
+	require.Equal(t, []AmountChange{{Denom: "ubear", Old: "5", New: "0", Delta: "-5"}}, diff.Supply)
+	require.Equal(t, []ParamChange{{Path: "staking.params.unbonding_time", Old: `"1814400s"`, New: `"1209600s"`}}, diff.Params)
+
+	var text bytes.Buffer
+	require.NoError(t, diff.WriteText(&text))
+	require.Contains(t, text.String(), "Accounts: 1 added, 1 removed\n")
+	require.Contains(t, text.String(), "  gadikian1c ugadikian: 0 -> 70 (+70)\n")
+	require.Contains(t, text.String(), "  staking.params.unbonding_time: \"1814400s\" -> \"1209600s\"\n")
+
+	// A genesis has no differences with itself
+	same, err := DiffGenesis(oldGenesis, oldGenesis)
+	require.NoError(t, err)
+	require.True(t, same.Empty())
+
+	text.Reset()
+	require.NoError(t, same.WriteText(&text))
+	require.Equal(t, "No differences\n", text.String())
+}
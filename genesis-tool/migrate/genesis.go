@@ -0,0 +1,376 @@
+package migrate
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	dbm "github.com/cosmos/cosmos-db"
+	simapp "github.com/unicorn-research/chain"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// Modules are the codec and module basics of the app a genesis is built for.
+type Modules struct {
+	Codec        codec.JSONCodec
+	TxConfig     client.TxEncodingConfig
+	BasicManager module.BasicManager
+}
+
+// Generate the final genesis with the given app modules.
+//
+// The app state starts from the modules' DefaultGenesis, so any module not
+// touched by the migration keeps the same state `chaind init` would produce.
+// With mergePath it starts from that existing genesis instead, such as one
+// from `chaind init` with gentxs collected, and every module keeps its state
+// apart from what the migration adds to it.
+//
+// Auth and bank are then filled in with the migrated accounts and balances,
+// staking with the recreated validators, delegations and unbonding
+// delegations when requested, and the result is validated by every module
+// before it is returned. Module params, the chain-id and the consensus params
+// come from the manifest. The genesis time is data.GenesisTime, which
+// unbonding completion times and vesting end times are relative to.
+func generateGenesis(data *GenesisData, app Modules, mergePath string) (*genutiltypes.AppGenesis, error) {
+	manifest := data.Manifest
+
+	// Addresses are validated against the sdk config, so it must use the
+	// prefix they were converted to
+	if prefix := sdk.GetConfig().GetBech32AccountAddrPrefix(); prefix != manifest.Prefixes.Target {
+		return nil, fmt.Errorf("the app uses address prefix %s, but the migration is to %s", prefix, manifest.Prefixes.Target)
+	}
+
+	cdc := app.Codec
+	genesisState := app.BasicManager.DefaultGenesis(cdc)
+
+	var (
+		appGenesis *genutiltypes.AppGenesis
+		err        error
+	)
+	if mergePath != "" {
+		appGenesis, genesisState, err = loadMergeGenesis(mergePath, manifest.ChainID, genesisState)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := applyModuleParams(genesisState, manifest.ModuleParams); err != nil {
+		return nil, err
+	}
+
+	if err := setAuthGenesis(cdc, genesisState, data); err != nil {
+		return nil, err
+	}
+
+	if err := setBankGenesis(cdc, genesisState, data); err != nil {
+		return nil, err
+	}
+
+	if data.StakingMode == StakingModeDelegations {
+		if err := setStakingGenesis(cdc, genesisState, data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := setDistributionGenesis(cdc, genesisState, data); err != nil {
+		return nil, err
+	}
+
+	if err := app.BasicManager.ValidateGenesis(cdc, app.TxConfig, genesisState); err != nil {
+		return nil, fmt.Errorf("genesis failed validation: %w", err)
+	}
+
+	appState, err := json.MarshalIndent(genesisState, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal app state: %w", err)
+	}
+
+	consensusParams, err := manifest.consensusParams()
+	if err != nil {
+		return nil, err
+	}
+
+	if appGenesis == nil {
+		appGenesis = genutiltypes.NewAppGenesisWithVersion(manifest.ChainID, appState)
+	}
+	appGenesis.AppState = appState
+	appGenesis.GenesisTime = data.GenesisTime
+	appGenesis.Consensus.Params = consensusParams
+	if err := appGenesis.ValidateAndComplete(); err != nil {
+		return nil, fmt.Errorf("invalid genesis: %w", err)
+	}
+
+	return appGenesis, nil
+}
+
+// Load an existing genesis to merge the migration into.
+//
+// Gentxs in the existing genesis are signed for its chain-id, so it must be
+// the chain-id of the migration. Modules missing from the existing genesis
+// get their state from defaultState.
+func loadMergeGenesis(path, chainID string, defaultState simapp.GenesisState) (*genutiltypes.AppGenesis, simapp.GenesisState, error) {
+	appGenesis, err := genutiltypes.AppGenesisFromFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read genesis to merge into: %w", err)
+	}
+
+	if appGenesis.ChainID != chainID {
+		return nil, nil, fmt.Errorf("genesis to merge into has chain-id %s, but the migration is for %s", appGenesis.ChainID, chainID)
+	}
+
+	var genesisState simapp.GenesisState
+	if err := json.Unmarshal(appGenesis.AppState, &genesisState); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal app state of genesis to merge into: %w", err)
+	}
+
+	for module, state := range defaultState {
+		if _, ok := genesisState[module]; !ok {
+			genesisState[module] = state
+		}
+	}
+
+	return appGenesis, genesisState, nil
+}
+
+// Instantiate the app in memory, configured for the target address prefix, so
+// its codec, default genesis and module basics can be used to build the genesis.
+func newGenesisApp(prefix string) (Modules, func(), error) {
+	// The config is sealed once set, so it is left as it is when it already
+	// uses the target prefix, as on a second build in the same process
+	cfg := sdk.GetConfig()
+	if cfg.GetBech32AccountAddrPrefix() != prefix {
+		if err := setBech32Prefixes(cfg, prefix); err != nil {
+			return Modules{}, nil, err
+		}
+	}
+	cfg.Seal()
+
+	homeDir, err := os.MkdirTemp("", "genesis-tool")
+	if err != nil {
+		return Modules{}, nil, fmt.Errorf("failed to create temporary app home: %w", err)
+	}
+
+	app := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(homeDir))
+	modules := Modules{
+		Codec:        app.AppCodec(),
+		TxConfig:     app.TxConfig(),
+		BasicManager: app.BasicModuleManager,
+	}
+
+	return modules, func() { os.RemoveAll(homeDir) }, nil
+}
+
+// Set the bech32 prefixes of the sdk config, failing rather than panicking
+// when the config was already sealed with other prefixes.
+func setBech32Prefixes(cfg *sdk.Config, prefix string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("cannot use address prefix %s: %v", prefix, r)
+		}
+	}()
+
+	cfg.SetBech32PrefixForAccount(prefix, prefix+sdk.PrefixPublic)
+	cfg.SetBech32PrefixForValidator(
+		prefix+sdk.PrefixValidator+sdk.PrefixOperator,
+		prefix+sdk.PrefixValidator+sdk.PrefixOperator+sdk.PrefixPublic,
+	)
+	cfg.SetBech32PrefixForConsensusNode(
+		prefix+sdk.PrefixValidator+sdk.PrefixConsensus,
+		prefix+sdk.PrefixValidator+sdk.PrefixConsensus+sdk.PrefixPublic,
+	)
+
+	return nil
+}
+
+// Fill the auth genesis with an account for every migrated address, using a
+// vesting account where the address has a vesting schedule and a module
+// account, with the app's permissions, for the app's own module accounts.
+//
+// Accounts already in the genesis are kept as they are. Migrated accounts are
+// numbered after them, and a migrated address that already has an account
+// keeps that account.
+func setAuthGenesis(cdc codec.JSONCodec, genesisState simapp.GenesisState, data *GenesisData) error {
+	var authGenState authtypes.GenesisState
+	if err := cdc.UnmarshalJSON(genesisState[authtypes.ModuleName], &authGenState); err != nil {
+		return fmt.Errorf("failed to unmarshal auth genesis: %w", err)
+	}
+
+	accounts, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return fmt.Errorf("failed to unpack accounts: %w", err)
+	}
+
+	existing := make(map[string]bool, len(accounts))
+	firstAccountNumber := uint64(0)
+	for _, account := range accounts {
+		existing[account.GetAddress().String()] = true
+		if account.GetAccountNumber() >= firstAccountNumber {
+			firstAccountNumber = account.GetAccountNumber() + 1
+		}
+	}
+
+	perms := simapp.GetMaccPerms()
+	for address, accountNumber := range data.Accounts {
+		if existing[address] {
+			if _, ok := data.Vesting[address]; ok {
+				return fmt.Errorf("account %s already exists in the genesis and cannot be made a vesting account", address)
+			}
+			continue
+		}
+
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return fmt.Errorf("invalid account address %s: %w", address, err)
+		}
+
+		base := authtypes.NewBaseAccount(addr, nil, firstAccountNumber+accountNumber, 0)
+		if name, ok := data.ModuleAccounts[address]; ok {
+			accounts = append(accounts, authtypes.NewModuleAccount(base, name, perms[name]...))
+			continue
+		}
+
+		account, err := newGenesisAccount(base, data.Vesting[address])
+		if err != nil {
+			return err
+		}
+		accounts = append(accounts, account)
+	}
+
+	// Sort accounts by account number
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].GetAccountNumber() < accounts[j].GetAccountNumber()
+	})
+
+	packed, err := authtypes.PackAccounts(accounts)
+	if err != nil {
+		return fmt.Errorf("failed to pack accounts: %w", err)
+	}
+	authGenState.Accounts = packed
+
+	authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal auth genesis: %w", err)
+	}
+	genesisState[authtypes.ModuleName] = authGenStateBz
+
+	return nil
+}
+
+// Fill the bank genesis with the migrated balances, supply and denom metadata.
+//
+// Balances are sorted by address, and the coins of each balance are sorted by
+// denom with duplicate denoms merged, so the same snapshot always produces the
+// same genesis. Balances, supply and metadata already in the genesis are kept:
+// migrated coins are added to them, and existing metadata wins over the
+// derived one.
+func setBankGenesis(cdc codec.JSONCodec, genesisState simapp.GenesisState, data *GenesisData) error {
+	var bankGenState banktypes.GenesisState
+	if err := cdc.UnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenState); err != nil {
+		return fmt.Errorf("failed to unmarshal bank genesis: %w", err)
+	}
+
+	coins := make(map[string]sdk.Coins, len(bankGenState.Balances))
+	for _, balance := range bankGenState.Balances {
+		coins[balance.Address] = coins[balance.Address].Add(balance.Coins...)
+	}
+	for _, address := range data.Balances.addresses() {
+		coins[address] = coins[address].Add(data.Balances.balance(address)...)
+	}
+
+	addresses := make([]string, 0, len(coins))
+	for address := range coins {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	balances := make([]banktypes.Balance, 0, len(addresses))
+	for _, address := range addresses {
+		balances = append(balances, banktypes.Balance{
+			Address: address,
+			Coins:   coins[address],
+		})
+	}
+	bankGenState.Balances = balances
+
+	supply, err := toSDKCoins(data.Supply)
+	if err != nil {
+		return fmt.Errorf("invalid supply: %w", err)
+	}
+	bankGenState.Supply = bankGenState.Supply.Add(supply...)
+
+	described := make(map[string]bool, len(bankGenState.DenomMetadata))
+	for _, metadata := range bankGenState.DenomMetadata {
+		described[metadata.Base] = true
+	}
+	for _, metadata := range data.DenomMetadata {
+		if !described[metadata.Base] {
+			bankGenState.DenomMetadata = append(bankGenState.DenomMetadata, metadata)
+		}
+	}
+
+	bankGenStateBz, err := cdc.MarshalJSON(&bankGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bank genesis: %w", err)
+	}
+	genesisState[banktypes.ModuleName] = bankGenStateBz
+
+	return nil
+}
+
+// Fill the distribution genesis with the community pool.
+//
+// The distribution module checks in InitGenesis that its module account holds
+// exactly the community pool, so the pool must match what was credited to it.
+func setDistributionGenesis(cdc codec.JSONCodec, genesisState simapp.GenesisState, data *GenesisData) error {
+	if data.CommunityPool.IsZero() {
+		return nil
+	}
+
+	var distrGenState distrtypes.GenesisState
+	if err := cdc.UnmarshalJSON(genesisState[distrtypes.ModuleName], &distrGenState); err != nil {
+		return fmt.Errorf("failed to unmarshal distribution genesis: %w", err)
+	}
+
+	distrGenState.FeePool.CommunityPool = distrGenState.FeePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(data.CommunityPool...)...)
+
+	distrGenStateBz, err := cdc.MarshalJSON(&distrGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal distribution genesis: %w", err)
+	}
+	genesisState[distrtypes.ModuleName] = distrGenStateBz
+
+	return nil
+}
+
+// Convert snapshot coins to sdk.Coins, merging duplicate denoms and dropping zero amounts.
+func toSDKCoins(coins []Coin) (sdk.Coins, error) {
+	result := sdk.NewCoins()
+	for _, coin := range coins {
+		amount, ok := math.NewIntFromString(coin.Amount)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %q for %s", coin.Amount, coin.Denom)
+		}
+
+		sdkCoin := sdk.Coin{Denom: coin.Denom, Amount: amount}
+		if err := sdkCoin.Validate(); err != nil {
+			return nil, err
+		}
+
+		result = result.Add(sdkCoin)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,161 @@
+package migrate
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	simapp "github.com/unicorn-research/chain"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func TestLoadMergeGenesis(t *testing.T) {
+	t.Parallel()
+
+	defaultState := simapp.GenesisState{
+		"auth": json.RawMessage(`{"default":true}`),
+		"bank": json.RawMessage(`{"default":true}`),
+	}
+
+	tests := []struct {
+		name     string
+		chainID  string // Of the genesis to merge into, left out when empty
+		expected simapp.GenesisState
+		err      string
+	}{
+		{
+			// Modules missing from the genesis get their default state
+			name:    "existing genesis",
+			chainID: "gadikian-1",
+			expected: simapp.GenesisState{
+				"auth": json.RawMessage(`{"default":true}`),
+				"bank": json.RawMessage(`{"merged":true}`),
+			},
+		},
+		{
+			name:    "other chain-id",
+			chainID: "gadikian-2",
+			err:     "genesis to merge into has chain-id gadikian-2, but the migration is for gadikian-1",
+		},
+		{
+			name: "missing genesis",
+			err:  "failed to read genesis to merge into",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "genesis.json")
+			if tc.chainID != "" {
+				appGenesis := genutiltypes.NewAppGenesisWithVersion(tc.chainID, json.RawMessage(`{"bank":{"merged":true}}`))
+				require.NoError(t, appGenesis.SaveAs(path))
+			}
+
+			appGenesis, genesisState, err := loadMergeGenesis(path, "gadikian-1", defaultState)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.chainID, appGenesis.ChainID)
+			// The saved genesis is indented, so the states are compared as JSON
+			require.Len(t, genesisState, len(tc.expected))
+			for module, state := range tc.expected {
+				require.JSONEq(t, string(state), string(genesisState[module]))
+			}
+		})
+	}
+}
+
+func TestSetBankGenesis(t *testing.T) {
+	t.Parallel()
+
+	const (
+		holder = "gadikian1qqyl24rxge02cgkqnq4p2340s28kdd899g2kmn"
+		other  = "gadikian1qqxm5thy3xjwwmz8re26d6kdme9y60jfy4s4kt"
+	)
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	tests := []struct {
+		name     string
+		existing *banktypes.GenesisState
+		balances map[string]string
+		supply   string
+		metadata map[string]string // Display denoms keyed by base denom
+	}{
+		{
+			name:     "default genesis",
+			existing: banktypes.DefaultGenesisState(),
+			balances: map[string]string{holder: "5ugadikian"},
+			supply:   "5ugadikian",
+			metadata: map[string]string{"ubear": "bear", "ugadikian": "migrated"},
+		},
+		{
+			// Migrated coins add to the existing ones, and existing metadata wins
+			name: "merged genesis",
+			existing: &banktypes.GenesisState{
+				Params: banktypes.DefaultParams(),
+				Balances: []banktypes.Balance{
+					{Address: holder, Coins: sdk.NewCoins(sdk.NewInt64Coin("ugadikian", 10))},
+					{Address: other, Coins: sdk.NewCoins(sdk.NewInt64Coin("ubear", 3))},
+				},
+				Supply:        sdk.NewCoins(sdk.NewInt64Coin("ubear", 3), sdk.NewInt64Coin("ugadikian", 10)),
+				DenomMetadata: []banktypes.Metadata{{Base: "ugadikian", Display: "existing"}},
+			},
+			balances: map[string]string{holder: "15ugadikian", other: "3ubear"},
+			supply:   "3ubear,15ugadikian",
+			metadata: map[string]string{"ubear": "bear", "ugadikian": "existing"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			genesisState := simapp.GenesisState{banktypes.ModuleName: cdc.MustMarshalJSON(tc.existing)}
+
+			data := &GenesisData{
+				Manifest: DefaultManifest(),
+				Balances: newLedger(),
+				Supply:   []Coin{{Denom: "ugadikian", Amount: "5"}},
+				DenomMetadata: []banktypes.Metadata{
+					{Base: "ubear", Display: "bear"},
+					{Base: "ugadikian", Display: "migrated"},
+				},
+			}
+			require.NoError(t, data.Balances.addAmount(holder, "balances.csv", "ugadikian", math.NewInt(5)))
+
+			require.NoError(t, setBankGenesis(cdc, genesisState, data))
+
+			var bankGenState banktypes.GenesisState
+			require.NoError(t, cdc.UnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenState))
+
+			balances := make(map[string]string, len(bankGenState.Balances))
+			for i, balance := range bankGenState.Balances {
+				if i > 0 {
+					require.Less(t, bankGenState.Balances[i-1].Address, balance.Address)
+				}
+				balances[balance.Address] = balance.Coins.String()
+			}
+			require.Equal(t, tc.balances, balances)
+			require.Equal(t, tc.supply, bankGenState.Supply.String())
+
+			metadata := make(map[string]string, len(bankGenState.DenomMetadata))
+			for _, entry := range bankGenState.DenomMetadata {
+				metadata[entry.Base] = entry.Display
+			}
+			require.Equal(t, tc.metadata, metadata)
+		})
+	}
+}
@@ -0,0 +1,382 @@
+package migrate
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Ledger aggregates the coins credited to each address.
+//
+// Coins are held as sdk.Coins, so amounts of the same denom are summed, denoms
+// are kept sorted and zero amounts are dropped no matter how many input files
+// credit an address. The ledger also records which source each credit came
+// from, so addresses merged from several snapshot files can be reported, and
+// the coins each source credited.
+//
+// A spilling ledger, used by streamed migrations, instead converts each credit
+// to the target chain as it is made and sorts it on disk, keeping only the
+// total in memory. Its balances can then only be read in address order, with
+// each.
+type Ledger struct {
+	coins    map[string]sdk.Coins
+	sources  map[string][]string
+	bySource map[string]map[string]sdk.Coins // Keyed by address, then by source
+
+	spill    *spillSorter // Set for a spilling ledger
+	manifest *Manifest    // Converts the credits of a spilling ledger
+	credits  uint64       // Number of credits made to a spilling ledger
+	totals   sdk.Coins    // Sum of the credits made to a spilling ledger
+}
+
+// Create an empty ledger.
+func newLedger() *Ledger {
+	return &Ledger{
+		coins:    make(map[string]sdk.Coins),
+		sources:  make(map[string][]string),
+		bySource: make(map[string]map[string]sdk.Coins),
+	}
+}
+
+// Create a ledger that converts credits with m and sorts them in dir,
+// holding up to limit of them in memory.
+func newSpillLedger(m *Manifest, dir string, limit int) *Ledger {
+	ledger := newLedger()
+	ledger.spill = newSpillSorter(dir, limit)
+	ledger.manifest = m
+
+	return ledger
+}
+
+// Credit coins to address as a single entry from source.
+func (l *Ledger) add(address, source string, coins ...sdk.Coin) error {
+	if l.spill != nil {
+		return l.spillCredit(address, source, coins)
+	}
+
+	balance := l.coins[address]
+	credited := l.credited(address, source)
+	for _, coin := range coins {
+		if err := coin.Validate(); err != nil {
+			return fmt.Errorf("invalid coin for %s from %s: %w", address, source, err)
+		}
+		balance = balance.Add(coin)
+		credited = credited.Add(coin)
+	}
+
+	l.coins[address] = balance
+	l.addSource(address, source)
+	l.bySource[address][source] = credited
+
+	return nil
+}
+
+// Return the coins source credited to address, creating the entry if needed.
+func (l *Ledger) credited(address, source string) sdk.Coins {
+	if _, ok := l.bySource[address]; !ok {
+		l.bySource[address] = make(map[string]sdk.Coins)
+	}
+
+	return l.bySource[address][source]
+}
+
+// Return the amount of denom credited to address by any of sources.
+func (l *Ledger) amountFrom(address, denom string, sources ...string) math.Int {
+	amount := math.ZeroInt()
+	for _, source := range sources {
+		amount = amount.Add(l.bySource[address][source].AmountOf(denom))
+	}
+
+	return amount
+}
+
+// Set the balance of denom at address to amount, as an entry from source.
+//
+// The credits of every source keep adding up to the balance: an increase is
+// credited to source, and a decrease lowers the credits of every source in
+// proportion, so each keeps its share of what is left.
+func (l *Ledger) setAmount(address, source, denom string, amount math.Int) error {
+	if amount.IsNegative() {
+		return fmt.Errorf("invalid amount %s%s for %s from %s", amount, denom, address, source)
+	}
+
+	current := l.coins[address].AmountOf(denom)
+	switch {
+	case amount.GT(current):
+		increase := sdk.Coin{Denom: denom, Amount: amount.Sub(current)}
+		l.coins[address] = l.coins[address].Add(increase)
+		credited := l.credited(address, source)
+		l.bySource[address][source] = credited.Add(increase)
+	case amount.LT(current):
+		l.coins[address] = l.coins[address].Sub(sdk.Coin{Denom: denom, Amount: current.Sub(amount)})
+		l.scaleCredits(address, denom, current, amount)
+	default:
+		return nil
+	}
+	l.addSource(address, source)
+
+	return nil
+}
+
+// Scale the credits of denom at address, which add up to current, down to
+// add up to amount. Each is rounded down, and the last source credited
+// absorbs the rounding.
+func (l *Ledger) scaleCredits(address, denom string, current, amount math.Int) {
+	last := -1
+	for i, source := range l.sources[address] {
+		if l.bySource[address][source].AmountOf(denom).IsPositive() {
+			last = i
+		}
+	}
+
+	remaining := amount
+	for i, source := range l.sources[address] {
+		credited := l.bySource[address][source]
+		before := credited.AmountOf(denom)
+		if !before.IsPositive() {
+			continue
+		}
+
+		after := before.Mul(amount).Quo(current)
+		if i == last {
+			after = remaining
+		}
+		remaining = remaining.Sub(after)
+		l.bySource[address][source] = credited.Sub(sdk.Coin{Denom: denom, Amount: before.Sub(after)})
+	}
+}
+
+// Record that source changed the balance of address, unless it already did.
+func (l *Ledger) addSource(address, source string) {
+	if !slices.Contains(l.sources[address], source) {
+		l.sources[address] = append(l.sources[address], source)
+	}
+}
+
+// Credit a single amount of denom to address.
+func (l *Ledger) addAmount(address, source, denom string, amount math.Int) error {
+	return l.add(address, source, sdk.Coin{Denom: denom, Amount: amount})
+}
+
+// Return the coins credited to address.
+func (l *Ledger) balance(address string) sdk.Coins {
+	return l.coins[address]
+}
+
+// Return every address with a non-zero balance, sorted.
+func (l *Ledger) addresses() []string {
+	addresses := make([]string, 0, len(l.coins))
+	for address, coins := range l.coins {
+		if !coins.IsZero() {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+
+	return addresses
+}
+
+// Convert a credit to the target chain and add it to the spill.
+func (l *Ledger) spillCredit(address, source string, coins []sdk.Coin) error {
+	address, err := l.manifest.convertAddress(address)
+	if err != nil {
+		return err
+	}
+
+	l.credits++
+	for _, coin := range coins {
+		denom, err := l.manifest.convertDenom(coin.Denom)
+		if err != nil {
+			return err
+		}
+
+		converted := sdk.Coin{Denom: denom, Amount: coin.Amount}
+		if err := converted.Validate(); err != nil {
+			return fmt.Errorf("invalid coin for %s from %s: %w", address, source, err)
+		}
+
+		// Zero amounts are spilled too, so the credit is still listed as a source
+		entry := spillEntry{Address: address, Credit: l.credits, Source: source, Denom: denom, Amount: coin.Amount.String()}
+		if err := l.spill.add(entry); err != nil {
+			return err
+		}
+		l.totals = l.totals.Add(converted)
+	}
+
+	return nil
+}
+
+// Return whether the ledger spills its credits to disk.
+func (l *Ledger) spilled() bool {
+	return l.spill != nil
+}
+
+// Return the sum of all balances.
+func (l *Ledger) total() sdk.Coins {
+	if l.spill != nil {
+		return l.totals
+	}
+
+	total := sdk.NewCoins()
+	for _, coins := range l.coins {
+		total = total.Add(coins...)
+	}
+
+	return total
+}
+
+// Return a new ledger with every address and denom converted.
+//
+// Sources carry over, so an address that only collides with another after
+// conversion is reported as merged too. A spilling ledger is already
+// converted.
+func (l *Ledger) convert(m *Manifest) (*Ledger, error) {
+	if l.spill != nil {
+		return l, nil
+	}
+
+	// Convert in address order, so merged sources are listed deterministically
+	addresses := make([]string, 0, len(l.coins))
+	for address := range l.coins {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	converted := newLedger()
+	for _, address := range addresses {
+		coins := l.coins[address]
+		newAddress, err := m.convertAddress(address)
+		if err != nil {
+			return nil, err
+		}
+
+		balance := converted.coins[newAddress]
+		for _, coin := range coins {
+			denom, err := m.convertDenom(coin.Denom)
+			if err != nil {
+				return nil, err
+			}
+
+			newCoin := sdk.Coin{Denom: denom, Amount: coin.Amount}
+			if err := newCoin.Validate(); err != nil {
+				return nil, fmt.Errorf("invalid coin for %s: %w", newAddress, err)
+			}
+			balance = balance.Add(newCoin)
+		}
+
+		converted.coins[newAddress] = balance
+		for _, source := range l.sources[address] {
+			converted.addSource(newAddress, source)
+		}
+
+		for source, credited := range l.bySource[address] {
+			convertedCredit := converted.credited(newAddress, source)
+			for _, coin := range credited {
+				denom, err := m.convertDenom(coin.Denom)
+				if err != nil {
+					return nil, err
+				}
+				convertedCredit = convertedCredit.Add(sdk.Coin{Denom: denom, Amount: coin.Amount})
+			}
+			converted.bySource[newAddress][source] = convertedCredit
+		}
+	}
+
+	return converted, nil
+}
+
+// Call fn with the balance of every address with a non-zero balance, in
+// address order, along with the distinct sources of its credits in the order
+// they were first made.
+func (l *Ledger) each(fn func(address string, coins sdk.Coins, sources []string) error) error {
+	if l.spill == nil {
+		for _, address := range l.addresses() {
+			if err := fn(address, l.coins[address], l.sources[address]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return l.spill.merge(func(address string, entries []spillEntry) error {
+		var (
+			coins   sdk.Coins
+			sources []string
+			credit  uint64
+		)
+		for _, entry := range entries {
+			amount, ok := math.NewIntFromString(entry.Amount)
+			if !ok {
+				return fmt.Errorf("invalid spilled amount %q for %s", entry.Amount, address)
+			}
+			coins = coins.Add(sdk.Coin{Denom: entry.Denom, Amount: amount})
+
+			if entry.Credit != credit && !slices.Contains(sources, entry.Source) {
+				sources = append(sources, entry.Source)
+			}
+			credit = entry.Credit
+		}
+		if coins.IsZero() {
+			return nil
+		}
+
+		return fn(address, coins, sources)
+	})
+}
+
+// Write the addresses merged from more than one snapshot file to
+// merged_addresses.csv, listing those files.
+//
+// Credits made by the migration itself, such as module account redirects,
+// parked supply or allocation rules, are not merges and are left out.
+func writeMergeReport(data *GenesisData, reportDir string) error {
+	file, err := os.Create(filepath.Join(reportDir, "merged_addresses.csv"))
+	if err != nil {
+		return fmt.Errorf("failed to create merge report: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	if err := writer.Write([]string{"address", "sources", "coins"}); err != nil {
+		return fmt.Errorf("failed to write merge report: %w", err)
+	}
+
+	merged := 0
+	err = data.Balances.each(func(address string, coins sdk.Coins, sources []string) error {
+		files := make([]string, 0, len(sources))
+		for _, source := range sources {
+			if data.Manifest.Input.isSnapshotSource(source) {
+				files = append(files, source)
+			}
+		}
+		if len(files) < 2 {
+			return nil
+		}
+
+		merged++
+		if err := writer.Write([]string{address, strings.Join(files, ";"), coins.String()}); err != nil {
+			return fmt.Errorf("failed to write merge report: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write merge report: %w", err)
+	}
+
+	data.logf("Merged balances of %d addresses credited from more than one snapshot file, see merged_addresses.csv\n", merged)
+
+	return nil
+}
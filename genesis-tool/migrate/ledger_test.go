@@ -0,0 +1,132 @@
+package migrate
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestWriteMergeReport(t *testing.T) {
+	t.Parallel()
+
+	const (
+		single    = "unicorn1qqyl24rxge02cgkqnq4p2340s28kdd89zld79f"
+		merged    = "unicorn1qqxm5thy3xjwwmz8re26d6kdme9y60jfrzhag3"
+		redirect  = "unicorn1qq856jck3gcqax4jsu3pdqa5kxjc3smysgyjm3"
+		repeated  = "unicorn1qq2ykjexxu3xh25lr9yktf2gxdw75mg8wfvkns"
+		allocated = "unicorn1qqs959pype5xexrd5gkvw0p8yskga3vg9amm7f"
+	)
+
+	credits := []struct {
+		address string
+		source  string
+		amount  int64
+	}{
+		// Two rows of one file are not a merge
+		{single, "balances.csv", 1},
+		{single, "balances.csv", 2},
+		{merged, "balances.csv", 3},
+		{merged, "kaway_bond.csv", 4},
+		// Credits made by the migration are not merges either
+		{redirect, "kaway_bond.csv", 5},
+		{redirect, moduleAccountSource, 6},
+		{allocated, "lp_bals.csv", 7},
+		{allocated, "--park-address", 8},
+		// Each file is listed once, in the order it first credited
+		{repeated, "kaway_bond.csv", 9},
+		{repeated, "balances.csv", 10},
+		{repeated, "kaway_bond.csv", 11},
+	}
+
+	tests := []struct {
+		name   string
+		ledger func(m *Manifest, dir string) *Ledger
+		// A spilling ledger converts its credits to the target chain
+		converted bool
+	}{
+		{name: "in memory", ledger: func(*Manifest, string) *Ledger { return newLedger() }},
+		{name: "spilled", ledger: func(m *Manifest, dir string) *Ledger { return newSpillLedger(m, dir, 2) }, converted: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := &GenesisData{Manifest: DefaultManifest()}
+			data.Balances = tc.ledger(data.Manifest, t.TempDir())
+			for _, credit := range credits {
+				require.NoError(t, data.Balances.addAmount(credit.address, credit.source, BondDenom, math.NewInt(credit.amount)))
+			}
+
+			reportDir := t.TempDir()
+			require.NoError(t, writeMergeReport(data, reportDir))
+
+			file, err := os.Open(filepath.Join(reportDir, "merged_addresses.csv"))
+			require.NoError(t, err)
+			defer file.Close()
+			rows, err := csv.NewReader(file).ReadAll()
+			require.NoError(t, err)
+
+			row := func(address, sources, amount string) []string {
+				denom := BondDenom
+				if tc.converted {
+					address, err = data.Manifest.convertAddress(address)
+					require.NoError(t, err)
+					denom, err = data.Manifest.convertDenom(denom)
+					require.NoError(t, err)
+				}
+				return []string{address, sources, amount + denom}
+			}
+			require.Equal(t, [][]string{
+				{"address", "sources", "coins"},
+				row(repeated, "kaway_bond.csv;balances.csv", "30"),
+				row(merged, "balances.csv;kaway_bond.csv", "7"),
+			}, rows)
+		})
+	}
+}
+
+func TestSetAmount(t *testing.T) {
+	t.Parallel()
+
+	const (
+		address = "unicorn1qqyl24rxge02cgkqnq4p2340s28kdd89zld79f"
+		source  = "balances.csv"
+	)
+
+	tests := []struct {
+		name    string
+		credits []int64
+		amount  int64
+		// Expected credits of source and of AllocationRulesSource
+		credited  int64
+		allocated int64
+	}{
+		{name: "new address", amount: 5, allocated: 5},
+		{name: "increase", credits: []int64{3}, amount: 5, credited: 3, allocated: 2},
+		{name: "decrease", credits: []int64{4}, amount: 1, credited: 1},
+		{name: "unchanged", credits: []int64{4}, amount: 4, credited: 4},
+		{name: "to zero", credits: []int64{4}, amount: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ledger := newLedger()
+			for _, credit := range tc.credits {
+				require.NoError(t, ledger.addAmount(address, source, BondDenom, math.NewInt(credit)))
+			}
+
+			require.NoError(t, ledger.setAmount(address, AllocationRulesSource, BondDenom, math.NewInt(tc.amount)))
+			require.Equal(t, math.NewInt(tc.amount), ledger.coins[address].AmountOf(BondDenom))
+			require.Equal(t, math.NewInt(tc.credited), ledger.amountFrom(address, BondDenom, source))
+			require.Equal(t, math.NewInt(tc.allocated), ledger.amountFrom(address, BondDenom, AllocationRulesSource))
+		})
+	}
+}
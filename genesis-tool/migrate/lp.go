@@ -0,0 +1,370 @@
+package migrate
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LPDenom is the base denom paired against every meme token in the liquidity pools.
+const LPDenom = "uwunicorn"
+
+// Pool holds the reserves and outstanding LP shares of a single liquidity pool.
+type Pool struct {
+	Denom       string
+	UwuReserve  *big.Int
+	MemeReserve *big.Int
+	TotalShares *big.Int
+}
+
+// LPReport summarizes how the shares of a single pool were redeemed.
+type LPReport struct {
+	Pool         *Pool
+	Holders      int
+	SharesHeld   *big.Int
+	UwuRedeemed  *big.Int
+	MemeRedeemed *big.Int
+}
+
+// Process pool_bals.csv, total_lps.csv and lp_bals.csv.
+//
+// Every LP share is redeemed into its pro-rata amount of the pool reserves
+// and the result is credited to the share holder's bank balance:
+//
+//	uwu  = shares * pool_uwu  / total_shares
+//	meme = shares * pool_meme / total_shares
+//
+// Amounts are rounded down, so the dust left behind in each pool is reported
+// rather than credited to anyone.
+func processLPs(data *GenesisData) error {
+	input := data.Manifest.Input
+
+	// LP processing needs all three files; without any of them there is nothing to redeem
+	for _, name := range []string{input.PoolBalances, input.LPBalances, input.TotalLPs} {
+		if !input.exists(name) {
+			data.logf("%s not found, skipping liquidity pool processing...\n", name)
+			return nil
+		}
+	}
+
+	poolFilePath := input.path(input.PoolBalances)
+	lpFilePath := input.path(input.LPBalances)
+	totalLPsFilePath := input.path(input.TotalLPs)
+
+	pools, err := loadPools(data, poolFilePath, totalLPsFilePath)
+	if err != nil {
+		return err
+	}
+
+	reports, err := redeemLPShares(lpFilePath, pools, data)
+	if err != nil {
+		return fmt.Errorf("error processing lp_bals: %w", err)
+	}
+
+	printLPReports(data, reports)
+
+	return nil
+}
+
+// Load pool reserves and total LP shares into a map keyed by pool denom.
+func loadPools(data *GenesisData, poolFilePath, totalLPsFilePath string) (map[string]*Pool, error) {
+	reserves, err := readDenomAmounts(data, poolFilePath, []string{"denom", "uwu", "meme"})
+	if err != nil {
+		return nil, fmt.Errorf("error processing pool_bals: %w", err)
+	}
+
+	shares, err := readDenomAmounts(data, totalLPsFilePath, []string{"denom", "shares"})
+	if err != nil {
+		return nil, fmt.Errorf("error processing total_lps: %w", err)
+	}
+
+	pools := make(map[string]*Pool, len(reserves))
+	for denom, amounts := range reserves {
+		total, ok := shares[denom]
+		if !ok {
+			return nil, fmt.Errorf("pool %s has reserves in pool_bals.csv but no entry in total_lps.csv", denom)
+		}
+
+		pools[denom] = &Pool{
+			Denom:       denom,
+			UwuReserve:  amounts[0],
+			MemeReserve: amounts[1],
+			TotalShares: total[0],
+		}
+	}
+
+	for denom := range shares {
+		if _, ok := pools[denom]; !ok {
+			return nil, fmt.Errorf("pool %s has shares in total_lps.csv but no entry in pool_bals.csv", denom)
+		}
+	}
+
+	return pools, nil
+}
+
+// Read a CSV file keyed by denom whose remaining columns are integer amounts.
+//
+// Bad rows are reported to data.RowErrors.
+func readDenomAmounts(data *GenesisData, filePath string, expectedHeader []string) (map[string][]*big.Int, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", filepath.Base(filePath), err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	if !headerMatches(header, expectedHeader) {
+		return nil, fmt.Errorf("unexpected header format in %s, expected: %v", filepath.Base(filePath), expectedHeader)
+	}
+
+	result := make(map[string][]*big.Int)
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading row: %w", err)
+		}
+
+		amounts, rowErr := parseDenomAmountsRow(data.Manifest, filepath.Base(filePath), recordLine(reader), header, row)
+		if rowErr != nil {
+			if err := data.RowErrors.report(rowErr); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		result[row[0]] = amounts
+	}
+
+	return result, nil
+}
+
+// Parse the amounts of a single row keyed by denom.
+func parseDenomAmountsRow(m *Manifest, fileName string, line int, header, row []string) ([]*big.Int, *RowError) {
+	if rowErr := m.checkDenom(fileName, line, header[0], row[0]); rowErr != nil {
+		return nil, rowErr
+	}
+
+	amounts := make([]*big.Int, 0, len(row)-1)
+	for i := 1; i < len(row); i++ {
+		amount, rowErr := parseAmountField(fileName, line, header[i], row[i])
+		if rowErr != nil {
+			return nil, rowErr
+		}
+		amounts = append(amounts, amount.BigInt())
+	}
+
+	return amounts, nil
+}
+
+// Redeem every LP share in lp_bals.csv and credit the underlying tokens.
+func redeemLPShares(filePath string, pools map[string]*Pool, data *GenesisData) (map[string]*LPReport, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", filepath.Base(filePath), err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	if header[0] != "address" {
+		return nil, fmt.Errorf("unexpected header format in %s, first column should be 'address'", filepath.Base(filePath))
+	}
+
+	reports := make(map[string]*LPReport, len(header)-1)
+	for _, denom := range header[1:] {
+		pool, ok := pools[denom]
+		if !ok {
+			return nil, fmt.Errorf("%s column %s has no matching pool", filepath.Base(filePath), denom)
+		}
+
+		reports[denom] = &LPReport{
+			Pool:         pool,
+			SharesHeld:   new(big.Int),
+			UwuRedeemed:  new(big.Int),
+			MemeRedeemed: new(big.Int),
+		}
+	}
+
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading row: %w", err)
+		}
+
+		line := recordLine(reader)
+		if rowErr := data.Manifest.checkAddress(filepath.Base(filePath), line, header[0], row[0]); rowErr != nil {
+			if err := data.RowErrors.report(rowErr); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := redeemLPRow(row, filepath.Base(filePath), line, header, pools, reports, data); err != nil {
+			return nil, err
+		}
+	}
+
+	return reports, nil
+}
+
+// Redeem the LP shares held by a single address.
+//
+// Shares are parsed before any is redeemed, so a skipped row leaves the pool
+// reports untouched.
+func redeemLPRow(row []string, fileName string, line int, header []string, pools map[string]*Pool, reports map[string]*LPReport, data *GenesisData) error {
+	address := row[0]
+	redeemed := make(map[string]*big.Int)
+
+	held := make(map[int]*big.Int)
+	for i := 1; i < len(header) && i < len(row); i++ {
+		shares, rowErr := parseAmountField(fileName, line, header[i], row[i])
+		if rowErr != nil {
+			return data.RowErrors.report(rowErr)
+		}
+		held[i] = shares.BigInt()
+	}
+
+	for i := 1; i < len(header) && i < len(row); i++ {
+		shares := held[i]
+		if shares.Sign() == 0 {
+			continue
+		}
+
+		pool := pools[header[i]]
+		uwu, meme := pool.redeem(shares)
+		addAmount(redeemed, LPDenom, uwu)
+		addAmount(redeemed, pool.Denom, meme)
+
+		report := reports[pool.Denom]
+		report.Holders++
+		report.SharesHeld.Add(report.SharesHeld, shares)
+		report.UwuRedeemed.Add(report.UwuRedeemed, uwu)
+		report.MemeRedeemed.Add(report.MemeRedeemed, meme)
+	}
+
+	if len(redeemed) == 0 {
+		return nil
+	}
+
+	ensureAccount(data, address)
+
+	coins := make([]sdk.Coin, 0, len(redeemed))
+	for denom, amount := range redeemed {
+		coins = append(coins, sdk.Coin{Denom: denom, Amount: math.NewIntFromBigInt(amount)})
+	}
+
+	return data.Balances.add(address, data.Manifest.Input.source(data.Manifest.Input.LPBalances), coins...)
+}
+
+// redeem returns the pro-rata share of both reserves for the given number of LP shares.
+func (p *Pool) redeem(shares *big.Int) (uwu, meme *big.Int) {
+	if p.TotalShares.Sign() == 0 {
+		return new(big.Int), new(big.Int)
+	}
+
+	uwu = new(big.Int).Mul(shares, p.UwuReserve)
+	uwu.Quo(uwu, p.TotalShares)
+
+	meme = new(big.Int).Mul(shares, p.MemeReserve)
+	meme.Quo(meme, p.TotalShares)
+
+	return uwu, meme
+}
+
+// Log a per-pool summary of the LP redemption.
+func printLPReports(data *GenesisData, reports map[string]*LPReport) {
+	denoms := make([]string, 0, len(reports))
+	for denom := range reports {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	totalUwu := new(big.Int)
+	uwuDust := new(big.Int)
+	for _, denom := range denoms {
+		report := reports[denom]
+		totalUwu.Add(totalUwu, report.UwuRedeemed)
+		uwuDust.Add(uwuDust, new(big.Int).Sub(report.Pool.UwuReserve, report.UwuRedeemed))
+
+		if report.SharesHeld.Cmp(report.Pool.TotalShares) != 0 {
+			data.logf("Warning: pool %s has %s shares held in lp_bals.csv but %s in total_lps.csv\n",
+				denom, report.SharesHeld, report.Pool.TotalShares)
+		}
+
+		memeDust := new(big.Int).Sub(report.Pool.MemeReserve, report.MemeRedeemed)
+		if memeDust.Sign() != 0 {
+			data.logf("Pool %s: %d holders redeemed, %s%s left unredeemed\n", denom, report.Holders, memeDust, denom)
+		}
+	}
+
+	data.logf("Redeemed LP shares in %d pools into %s%s (%s%s left unredeemed)\n",
+		len(denoms), totalUwu, LPDenom, uwuDust, LPDenom)
+}
+
+// Parse a non-negative integer amount, treating an empty field as zero.
+func parseAmount(s string) (*big.Int, error) {
+	if s == "" {
+		return new(big.Int), nil
+	}
+
+	amount, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("%q is not an integer", s)
+	}
+
+	if amount.Sign() < 0 {
+		return nil, fmt.Errorf("%q is negative", s)
+	}
+
+	return amount, nil
+}
+
+// Add amount to the running total for denom.
+func addAmount(totals map[string]*big.Int, denom string, amount *big.Int) {
+	if total, ok := totals[denom]; ok {
+		total.Add(total, amount)
+		return
+	}
+
+	totals[denom] = new(big.Int).Set(amount)
+}
+
+// Report whether header starts with the expected column names.
+func headerMatches(header, expected []string) bool {
+	if len(header) < len(expected) {
+		return false
+	}
+
+	for i, name := range expected {
+		if header[i] != name {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,119 @@
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessLPs(t *testing.T) {
+	t.Parallel()
+
+	const (
+		first  = "unicorn1qqyl24rxge02cgkqnq4p2340s28kdd89zld79f"
+		second = "unicorn1qqxm5thy3xjwwmz8re26d6kdme9y60jfrzhag3"
+	)
+
+	tests := []struct {
+		name     string
+		pools    string // pool_bals.csv, left out when empty
+		totals   string // total_lps.csv
+		shares   string // lp_bals.csv
+		expected map[string]string
+		err      string
+	}{
+		{
+			name:   "pro rata",
+			pools:  "denom,uwu,meme\nubear,1000,300\n",
+			totals: "denom,shares\nubear,100\n",
+			shares: "address,ubear\n" + first + ",60\n" + second + ",40\n",
+			expected: map[string]string{
+				first:  "180ubear,600uwunicorn",
+				second: "120ubear,400uwunicorn",
+			},
+		},
+		{
+			// The dust of 1uwunicorn and 1ubear stays in the pool
+			name:   "rounds down",
+			pools:  "denom,uwu,meme\nubear,100,10\n",
+			totals: "denom,shares\nubear,3\n",
+			shares: "address,ubear\n" + first + ",1\n" + second + ",2\n",
+			expected: map[string]string{
+				first:  "3ubear,33uwunicorn",
+				second: "6ubear,66uwunicorn",
+			},
+		},
+		{
+			name:   "several pools",
+			pools:  "denom,uwu,meme\nubear,1000,300\nucat,50,20\n",
+			totals: "denom,shares\nubear,100\nucat,10\n",
+			shares: "address,ubear,ucat\n" + first + ",50,10\n" + second + ",50,0\n",
+			expected: map[string]string{
+				first:  "150ubear,20ucat,550uwunicorn",
+				second: "150ubear,500uwunicorn",
+			},
+		},
+		{
+			name:     "missing pool file",
+			totals:   "denom,shares\nubear,100\n",
+			shares:   "address,ubear\n" + first + ",60\n",
+			expected: map[string]string{},
+		},
+		{
+			name:   "pool without total shares",
+			pools:  "denom,uwu,meme\nubear,1000,300\nucat,50,20\n",
+			totals: "denom,shares\nubear,100\n",
+			shares: "address,ubear\n" + first + ",60\n",
+			err:    "pool ucat has reserves in pool_bals.csv but no entry in total_lps.csv",
+		},
+		{
+			name:   "shares of an unknown pool",
+			pools:  "denom,uwu,meme\nubear,1000,300\n",
+			totals: "denom,shares\nubear,100\n",
+			shares: "address,ucat\n" + first + ",60\n",
+			err:    "column ucat has no matching pool",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			files := map[string]string{"pool_bals.csv": tc.pools, "total_lps.csv": tc.totals, "lp_bals.csv": tc.shares}
+			for name, contents := range files {
+				if contents == "" {
+					continue
+				}
+				require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o600))
+			}
+
+			manifest := DefaultManifest()
+			manifest.Input.Dir = dir
+
+			data := &GenesisData{
+				Manifest:  manifest,
+				Accounts:  make(map[string]uint64),
+				Balances:  newLedger(),
+				RowErrors: &RowErrors{},
+			}
+
+			err := processLPs(data)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Empty(t, data.RowErrors.Errors)
+
+			balances := make(map[string]string)
+			for _, address := range data.Balances.addresses() {
+				balances[address] = data.Balances.balance(address).String()
+				require.Contains(t, data.Accounts, address)
+			}
+			require.Equal(t, tc.expected, balances)
+		})
+	}
+}
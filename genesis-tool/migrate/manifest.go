@@ -0,0 +1,377 @@
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// Manifest describes a migration: how addresses and denoms are renamed, where
+// the snapshot files are, and the chain-level settings of the new genesis.
+//
+// Manifests are YAML or JSON. Every field except the genesis time and input
+// directory or archive is optional; omitted fields keep the values of
+// DefaultManifest, which is the unicorn to gadikian migration.
+type Manifest struct {
+	ChainID string `yaml:"chain_id"`
+	// GenesisTime is an RFC 3339 timestamp. It has no default, since the
+	// genesis must be identical for everyone who builds it.
+	GenesisTime string `yaml:"genesis_time"`
+
+	Prefixes  Prefixes        `yaml:"prefixes"`
+	Denoms    DenomRules      `yaml:"denoms"`
+	Consensus ConsensusConfig `yaml:"consensus"`
+	// ModuleParams overrides individual params of a module's default genesis,
+	// keyed by module name and then by param name as it appears in genesis.json.
+	ModuleParams   map[string]map[string]any `yaml:"module_params"`
+	ModuleAccounts ModuleAccountConfig       `yaml:"module_accounts"`
+	Input          InputFiles                `yaml:"input"`
+}
+
+// Prefixes are the bech32 account prefixes of the source and target chains.
+type Prefixes struct {
+	Source string `yaml:"source"`
+	Target string `yaml:"target"`
+}
+
+// DenomRules describe how denoms are renamed.
+type DenomRules struct {
+	// Rename maps source denoms to target denoms; denoms without a rule are kept.
+	Rename []DenomRename `yaml:"rename"`
+	// RewriteFactoryCreators re-encodes the creator address of factory/{creator}/{subdenom} denoms.
+	RewriteFactoryCreators bool `yaml:"rewrite_factory_creators"`
+}
+
+// DenomRename renames a single denom.
+type DenomRename struct {
+	From string `yaml:"from"`
+	To   string `yaml:"to"`
+}
+
+// ConsensusConfig holds the consensus params that differ from CometBFT's defaults.
+type ConsensusConfig struct {
+	Block struct {
+		MaxBytes int64 `yaml:"max_bytes"`
+		MaxGas   int64 `yaml:"max_gas"`
+	} `yaml:"block"`
+	Evidence struct {
+		MaxAgeNumBlocks int64 `yaml:"max_age_num_blocks"`
+		// MaxAgeDuration is a Go duration such as 48h.
+		MaxAgeDuration string `yaml:"max_age_duration"`
+	} `yaml:"evidence"`
+	Validator struct {
+		PubKeyTypes []string `yaml:"pub_key_types"`
+	} `yaml:"validator"`
+}
+
+// ModuleAccountConfig lists the source chain module accounts whose balances
+// are redirected, besides those of the app's own modules.
+type ModuleAccountConfig struct {
+	// SourceModules are module names of the source chain. Their accounts are
+	// derived from the name, as on any chain.
+	SourceModules []string `yaml:"source_modules"`
+	// Addresses are other module-owned addresses, with either prefix, keyed
+	// by address with a name for the report.
+	Addresses map[string]string `yaml:"addresses"`
+}
+
+// InputFiles maps each snapshot file to its name in the snapshot directory.
+type InputFiles struct {
+	// Dir is the snapshot directory, relative to the manifest file.
+	Dir string `yaml:"dir"`
+	// CAR is a CAR archive of the snapshot, relative to the manifest file,
+	// used instead of Dir. It is unpacked after checking that its root is CID.
+	CAR string `yaml:"car"`
+	// CID is the root CID of the CAR archive; it defaults to the archive's
+	// file name without the .car extension.
+	CID string `yaml:"cid"`
+
+	Supply       string `yaml:"supply"`
+	Balances     string `yaml:"balances"`
+	KawayBond    string `yaml:"kaway_bond"`
+	UwuvalBond   string `yaml:"uwuval_bond"`
+	KawayUnbond  string `yaml:"kaway_unbond"`
+	UwuvalUnbond string `yaml:"uwuval_unbond"`
+	PoolBalances string `yaml:"pool_bals"`
+	LPBalances   string `yaml:"lp_bals"`
+	TotalLPs     string `yaml:"total_lps"`
+}
+
+// DefaultManifest returns the unicorn to gadikian migration.
+func DefaultManifest() *Manifest {
+	manifest := &Manifest{
+		ChainID: "gadikian-1",
+		Prefixes: Prefixes{
+			Source: "unicorn",
+			Target: "gadikian",
+		},
+		Denoms: DenomRules{
+			Rename: []DenomRename{
+				{From: "uwunicorn", To: "ugadikian"},
+			},
+			RewriteFactoryCreators: true,
+		},
+		ModuleParams: make(map[string]map[string]any),
+		ModuleAccounts: ModuleAccountConfig{
+			// Modules of the unicorn chain that gadikian does not have
+			SourceModules: []string{"crisis", "tokenfactory", "wasm", "feeibc"},
+		},
+		Input: InputFiles{
+			Supply:       "supply.csv",
+			Balances:     "balances.csv",
+			KawayBond:    "kaway_bond.csv",
+			UwuvalBond:   "uwuval_bond.csv",
+			KawayUnbond:  "kaway_unbond.csv",
+			UwuvalUnbond: "uwuval_unbond.csv",
+			PoolBalances: "pool_bals.csv",
+			LPBalances:   "lp_bals.csv",
+			TotalLPs:     "total_lps.csv",
+		},
+	}
+
+	// Consensus params carried over from the unicorn chain
+	manifest.Consensus.Block.MaxBytes = 22020096
+	manifest.Consensus.Block.MaxGas = -1
+	manifest.Consensus.Evidence.MaxAgeNumBlocks = 100000
+	manifest.Consensus.Evidence.MaxAgeDuration = "48h"
+	manifest.Consensus.Validator.PubKeyTypes = []string{"ed25519"}
+
+	return manifest
+}
+
+// SnapshotManifest returns DefaultManifest reading the snapshot at path, a
+// directory of CSV files or a CAR archive of one named after its root CID.
+func SnapshotManifest(path string) *Manifest {
+	manifest := DefaultManifest()
+	if strings.HasSuffix(path, ".car") {
+		manifest.Input.CAR = path
+	} else {
+		manifest.Input.Dir = path
+	}
+
+	return manifest
+}
+
+// LoadManifest loads a manifest file on top of DefaultManifest.
+//
+// A relative input directory or CAR archive is resolved against the directory
+// of the manifest file, so a manifest can be kept next to its snapshot.
+func LoadManifest(path string) (*Manifest, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	// Reject unknown fields, so a misspelled setting is not silently ignored
+	decoder := yaml.NewDecoder(bytes.NewReader(bz))
+	decoder.KnownFields(true)
+
+	manifest := DefaultManifest()
+	if err := decoder.Decode(manifest); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse manifest %s: %w", filepath.Base(path), err)
+	}
+
+	if (manifest.Input.Dir == "") == (manifest.Input.CAR == "") {
+		return nil, errors.New("manifest must set exactly one of input.dir and input.car")
+	}
+	if manifest.Input.Dir != "" && !filepath.IsAbs(manifest.Input.Dir) {
+		manifest.Input.Dir = filepath.Join(filepath.Dir(path), manifest.Input.Dir)
+	}
+	if manifest.Input.CAR != "" && !filepath.IsAbs(manifest.Input.CAR) {
+		manifest.Input.CAR = filepath.Join(filepath.Dir(path), manifest.Input.CAR)
+	}
+
+	return manifest, nil
+}
+
+// Validate checks that the manifest describes a usable migration.
+func (m *Manifest) Validate() error {
+	if m.ChainID == "" {
+		return errors.New("chain_id must not be empty")
+	}
+
+	if _, err := m.genesisTime(); err != nil {
+		return err
+	}
+
+	if m.Prefixes.Source == "" || m.Prefixes.Target == "" {
+		return errors.New("prefixes.source and prefixes.target must not be empty")
+	}
+
+	seen := make(map[string]bool, len(m.Denoms.Rename))
+	for _, rule := range m.Denoms.Rename {
+		if rule.From == "" || rule.To == "" {
+			return errors.New("denom rename rules need both from and to")
+		}
+		if seen[rule.From] {
+			return fmt.Errorf("duplicate denom rename rule for %s", rule.From)
+		}
+		seen[rule.From] = true
+	}
+
+	if _, err := m.consensusParams(); err != nil {
+		return err
+	}
+
+	if m.Input.Supply == "" {
+		return errors.New("input.supply must not be empty")
+	}
+
+	return nil
+}
+
+// Return the genesis time.
+func (m *Manifest) genesisTime() (time.Time, error) {
+	if m.GenesisTime == "" {
+		return time.Time{}, errors.New("genesis_time must be set")
+	}
+
+	genesisTime, err := time.Parse(time.RFC3339, m.GenesisTime)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid genesis_time: %w", err)
+	}
+
+	return genesisTime.UTC(), nil
+}
+
+// Build the consensus params, starting from CometBFT's defaults.
+func (m *Manifest) consensusParams() (*cmttypes.ConsensusParams, error) {
+	maxAgeDuration, err := time.ParseDuration(m.Consensus.Evidence.MaxAgeDuration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid consensus.evidence.max_age_duration: %w", err)
+	}
+
+	params := cmttypes.DefaultConsensusParams()
+	params.Block.MaxBytes = m.Consensus.Block.MaxBytes
+	params.Block.MaxGas = m.Consensus.Block.MaxGas
+	params.Evidence.MaxAgeNumBlocks = m.Consensus.Evidence.MaxAgeNumBlocks
+	params.Evidence.MaxAgeDuration = maxAgeDuration
+	params.Validator.PubKeyTypes = m.Consensus.Validator.PubKeyTypes
+
+	if err := params.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid consensus params: %w", err)
+	}
+
+	return params, nil
+}
+
+// Unpack the CAR archive of the snapshot, if there is one, and point Dir at
+// the unpacked files.
+//
+// The returned function removes the unpacked files.
+func (f *InputFiles) unpack(logf func(format string, args ...any)) (func(), error) {
+	if f.CAR == "" {
+		return func() {}, nil
+	}
+
+	cid := f.CID
+	if cid == "" {
+		cid = strings.TrimSuffix(filepath.Base(f.CAR), ".car")
+	}
+
+	dir, err := os.MkdirTemp("", "genesis-tool-snapshot")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+
+	if err := unpackCAR(f.CAR, cid, dir); err != nil {
+		cleanup()
+		return nil, err
+	}
+
+	logf("Verified snapshot %s against root CID %s\n", filepath.Base(f.CAR), cid)
+	f.Dir = dir
+
+	return cleanup, nil
+}
+
+// Return the path of a snapshot file.
+func (f InputFiles) path(name string) string {
+	return filepath.Join(f.Dir, name)
+}
+
+// Return the ledger source of the credits read from a snapshot file.
+func (f InputFiles) source(name string) string {
+	return filepath.Base(name)
+}
+
+// Report whether a ledger source is a snapshot file, rather than a step of the
+// migration such as a module account redirect or an allocation rule.
+func (f InputFiles) isSnapshotSource(source string) bool {
+	for _, name := range []string{f.Balances, f.KawayBond, f.UwuvalBond, f.KawayUnbond, f.UwuvalUnbond, f.LPBalances} {
+		if name != "" && f.source(name) == source {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Report whether an optional snapshot file is mapped and present.
+func (f InputFiles) exists(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	_, err := os.Stat(f.path(name))
+	return err == nil
+}
+
+// Override module params in the genesis state with those in the manifest.
+//
+// Only the listed params change; every other param keeps its default value.
+// The result is checked by the modules' ValidateGenesis like the rest of the
+// genesis.
+func applyModuleParams(genesisState map[string]json.RawMessage, moduleParams map[string]map[string]any) error {
+	for module, overrides := range moduleParams {
+		moduleState, ok := genesisState[module]
+		if !ok {
+			return fmt.Errorf("module_params: unknown module %s", module)
+		}
+
+		var state map[string]json.RawMessage
+		if err := json.Unmarshal(moduleState, &state); err != nil {
+			return fmt.Errorf("module_params: failed to unmarshal %s genesis: %w", module, err)
+		}
+
+		params := make(map[string]json.RawMessage)
+		if existing, ok := state["params"]; ok {
+			if err := json.Unmarshal(existing, &params); err != nil {
+				return fmt.Errorf("module_params: failed to unmarshal %s params: %w", module, err)
+			}
+		}
+
+		for name, value := range overrides {
+			bz, err := json.Marshal(value)
+			if err != nil {
+				return fmt.Errorf("module_params: invalid value for %s.%s: %w", module, name, err)
+			}
+			params[name] = bz
+		}
+
+		paramsBz, err := json.Marshal(params)
+		if err != nil {
+			return fmt.Errorf("module_params: failed to marshal %s params: %w", module, err)
+		}
+		state["params"] = paramsBz
+
+		stateBz, err := json.Marshal(state)
+		if err != nil {
+			return fmt.Errorf("module_params: failed to marshal %s genesis: %w", module, err)
+		}
+		genesisState[module] = stateBz
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package migrate
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// DefaultDisplayExponent is the exponent of the display unit of micro denoms.
+const DefaultDisplayExponent = 6
+
+// DenomMetadataColumns are the columns a denom metadata CSV may override.
+var DenomMetadataColumns = []string{"display", "exponent", "symbol", "name", "description", "uri"}
+
+// Build bank metadata for every denom in the migrated supply and balances.
+//
+// Values are derived from each denom's sub-denom, the part after the last /:
+// a sub-denom starting with u is a micro unit, so ufoo gets a display unit foo
+// with exponent 6, symbol FOO and name foo, and factory/{creator}/ufoo gets
+// factory/{creator}/foo. Any other denom is its own display unit with
+// exponent 0. The optional CSV in overridesPath overrides individual values,
+// keyed by source or target denom.
+func buildDenomMetadata(data *GenesisData, overridesPath string) ([]banktypes.Metadata, error) {
+	denoms := make(map[string]bool)
+	for _, coin := range data.Supply {
+		denoms[coin.Denom] = true
+	}
+	for _, coin := range data.Balances.total() {
+		denoms[coin.Denom] = true
+	}
+
+	overrides := make(map[string]map[string]string)
+	if overridesPath != "" {
+		var err error
+		overrides, err = readDenomMetadataOverrides(data.Manifest, overridesPath)
+		if err != nil {
+			return nil, fmt.Errorf("error processing denom metadata: %w", err)
+		}
+	}
+
+	for denom := range overrides {
+		if !denoms[denom] {
+			return nil, fmt.Errorf("denom metadata override for %s, which is not a migrated denom", denom)
+		}
+	}
+
+	sorted := make([]string, 0, len(denoms))
+	for denom := range denoms {
+		sorted = append(sorted, denom)
+	}
+	sort.Strings(sorted)
+
+	metadata := make([]banktypes.Metadata, 0, len(sorted))
+	for _, denom := range sorted {
+		denomMetadata, err := newDenomMetadata(denom, overrides[denom])
+		if err != nil {
+			return nil, err
+		}
+		metadata = append(metadata, denomMetadata)
+	}
+
+	return metadata, nil
+}
+
+// Build the metadata of a single denom, applying any overridden values.
+func newDenomMetadata(denom string, override map[string]string) (banktypes.Metadata, error) {
+	// Keep the path of factory denoms in the display unit, so equal sub-denoms
+	// of different creators stay distinct
+	path, subdenom := "", denom
+	if i := strings.LastIndex(denom, "/"); i >= 0 {
+		path, subdenom = denom[:i+1], denom[i+1:]
+	}
+
+	name := subdenom
+	display := denom
+	exponent := uint64(0)
+	if trimmed := strings.TrimPrefix(subdenom, "u"); trimmed != subdenom && sdk.ValidateDenom(path+trimmed) == nil {
+		name = trimmed
+		display = path + trimmed
+		exponent = DefaultDisplayExponent
+	}
+
+	metadata := banktypes.Metadata{
+		Base:   denom,
+		Name:   name,
+		Symbol: strings.ToUpper(name),
+	}
+
+	if value := override["display"]; value != "" {
+		display = value
+	}
+	if value := override["exponent"]; value != "" {
+		parsed, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return banktypes.Metadata{}, fmt.Errorf("invalid exponent %q for %s: %w", value, denom, err)
+		}
+		exponent = parsed
+	}
+	if value := override["symbol"]; value != "" {
+		metadata.Symbol = value
+	}
+	if value := override["name"]; value != "" {
+		metadata.Name = value
+	}
+	metadata.Description = override["description"]
+	metadata.URI = override["uri"]
+
+	// The display unit is the base unit itself when there is no exponent
+	if exponent == 0 {
+		display = denom
+	}
+
+	metadata.Display = display
+	metadata.DenomUnits = []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}}
+	if display != denom {
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{Denom: display, Exponent: uint32(exponent)})
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return banktypes.Metadata{}, fmt.Errorf("invalid metadata for %s: %w", denom, err)
+	}
+
+	return metadata, nil
+}
+
+// Read the denom metadata overrides, keyed by target denom.
+//
+// The first column is denom; the others are any of DenomMetadataColumns. An
+// empty value keeps the derived one.
+func readDenomMetadataOverrides(m *Manifest, filePath string) (map[string]map[string]string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", filepath.Base(filePath), err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	if header[0] != "denom" {
+		return nil, fmt.Errorf("unexpected header format in %s, first column should be 'denom'", filepath.Base(filePath))
+	}
+
+	for _, column := range header[1:] {
+		known := false
+		for _, name := range DenomMetadataColumns {
+			known = known || column == name
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown column %s in %s, expected any of %v", column, filepath.Base(filePath), DenomMetadataColumns)
+		}
+	}
+
+	overrides := make(map[string]map[string]string)
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading row: %w", err)
+		}
+
+		// Overrides may name a denom as it appears in the snapshot or in the genesis
+		denom, err := m.convertDenom(row[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", filepath.Base(filePath), recordLine(reader), err)
+		}
+
+		if _, exists := overrides[denom]; exists {
+			return nil, fmt.Errorf("duplicate entry for %s in %s", denom, filepath.Base(filePath))
+		}
+
+		override := make(map[string]string, len(header)-1)
+		for i, column := range header[1:] {
+			override[column] = row[i+1]
+		}
+		overrides[denom] = override
+	}
+
+	return overrides, nil
+}
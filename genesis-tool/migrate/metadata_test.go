@@ -0,0 +1,61 @@
+package migrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDenomMetadata(t *testing.T) {
+	t.Parallel()
+
+	const factoryPath = "factory/gadikian1rn9f6ack3u8t3ed04pfaqpmh5zfp2m2lczu747/"
+
+	tests := []struct {
+		name     string
+		denom    string
+		override map[string]string
+		display  string
+		exponent uint32
+		symbol   string
+		err      string
+	}{
+		{name: "micro denom", denom: "ugadikian", display: "gadikian", exponent: 6, symbol: "GADIKIAN"},
+		{name: "plain denom", denom: "valgadikian", display: "valgadikian", symbol: "VALGADIKIAN"},
+		{name: "factory denom", denom: factoryPath + "ubear", display: factoryPath + "bear", exponent: 6, symbol: "BEAR"},
+		{name: "short factory denom", denom: factoryPath + "usa", display: factoryPath + "sa", exponent: 6, symbol: "SA"},
+		{name: "display unit too short", denom: "uab", display: "uab", symbol: "UAB"},
+		{
+			name:     "overridden",
+			denom:    "ugadikian",
+			override: map[string]string{"display": "gad", "exponent": "18", "symbol": "GAD"},
+			display:  "gad",
+			exponent: 18,
+			symbol:   "GAD",
+		},
+		{name: "overridden without exponent", denom: "ugadikian", override: map[string]string{"exponent": "0"}, display: "ugadikian", symbol: "GADIKIAN"},
+		{name: "invalid exponent", denom: "ugadikian", override: map[string]string{"exponent": "six"}, err: "invalid exponent"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			metadata, err := newDenomMetadata(tc.denom, tc.override)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.denom, metadata.Base)
+			require.Equal(t, tc.display, metadata.Display)
+			require.Equal(t, tc.symbol, metadata.Symbol)
+			require.Equal(t, tc.denom, metadata.DenomUnits[0].Denom)
+
+			last := metadata.DenomUnits[len(metadata.DenomUnits)-1]
+			require.Equal(t, tc.display, last.Denom)
+			require.Equal(t, tc.exponent, last.Exponent)
+		})
+	}
+}
@@ -0,0 +1,357 @@
+// Package migrate converts a unicorn state snapshot into a gadikian genesis.
+//
+// A migration runs in three steps: LoadSnapshot reads the snapshot CSV files,
+// Convert turns what was read into target chain accounts, balances and
+// staking state, and Build assembles and validates the genesis from them.
+// A migration loaded with Options.Stream keeps its balances on disk instead,
+// and StreamGenesis writes its genesis in place of Build.
+package migrate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// Coin is a token amount as read from the snapshot CSV files.
+type Coin struct {
+	Denom  string
+	Amount string
+}
+
+// GenesisData holds the state of a migration between its steps.
+type GenesisData struct {
+	Manifest *Manifest // The migration being performed
+	Options  Options
+
+	Accounts       map[string]uint64 // Account numbers keyed by address
+	Balances       *Ledger
+	Supply         []Coin
+	DenomMetadata  []banktypes.Metadata // Sorted by base denom
+	CommunityPool  sdk.Coins            // Held by the distribution module account
+	ModuleAccounts map[string]string    // Module names of migrated app module accounts, keyed by address
+	AccountCounter uint64               // Counter for assigning sequential account numbers
+
+	StakingMode string                // StakingModeLiquid or StakingModeDelegations
+	Validators  map[string]*Validator // Keyed by operator account address
+	Delegations []*Delegation
+
+	UnbondingPolicy string // UnbondingPolicyLiquid, UnbondingPolicyVesting or UnbondingPolicyUnbonding
+	Unbondings      []*Unbonding
+	Vesting         map[string]*VestingSchedule // Keyed by account address
+
+	GenesisTime time.Time
+
+	RowErrors *RowErrors // Bad rows skipped, or the first one in strict mode
+
+	spillDir string // Holds the sorted balances of a streamed migration
+}
+
+// Options control how a snapshot is migrated. Paths left empty disable the
+// step that reads them.
+type Options struct {
+	StakingMode     string // StakingModeLiquid or StakingModeDelegations
+	ValidatorKeys   string // CSV of address,pubkey consensus keys, delegations mode only
+	ValidatorMap    string // CSV of delegator,validator fallbacks, delegations mode only
+	UnbondingPolicy string // UnbondingPolicyLiquid, UnbondingPolicyVesting or UnbondingPolicyUnbonding
+
+	SupplyPolicy string // SupplyPolicyFail, SupplyPolicyRecompute or SupplyPolicyPark
+	ParkAddress  string // Receives unallocated supply with SupplyPolicyPark
+
+	ModuleAccountPolicy    string // ModuleAccountPolicyCommunityPool, ModuleAccountPolicyBurn or ModuleAccountPolicyRecipient
+	ModuleAccountRecipient string // Receives module account balances with ModuleAccountPolicyRecipient
+
+	AllocationRules string // YAML allocation rules
+	VestingPolicy   string // YAML vesting policy
+	DenomMetadata   string // CSV of denom metadata overrides
+	MergeGenesis    string // Existing genesis to merge the migration into
+
+	ReportDir string    // Directory the reports are written to
+	Strict    bool      // Abort on the first bad row instead of skipping it
+	Log       io.Writer // Progress output; discarded when nil
+
+	Stream      bool // Sort balances on disk and write the genesis with StreamGenesis
+	SpillBuffer int  // Credits held in memory while streaming; DefaultSpillBuffer when 0
+}
+
+// DefaultOptions returns the options of a plain migration: bonded and
+// unbonding amounts become liquid balances, module account balances go to the
+// community pool and the supply must match the balances.
+func DefaultOptions() Options {
+	return Options{
+		StakingMode:         StakingModeLiquid,
+		UnbondingPolicy:     UnbondingPolicyLiquid,
+		SupplyPolicy:        SupplyPolicyFail,
+		ModuleAccountPolicy: ModuleAccountPolicyCommunityPool,
+		ReportDir:           ".",
+	}
+}
+
+// Validate checks that the options name known modes and policies.
+func (o Options) Validate() error {
+	if o.StakingMode != StakingModeLiquid && o.StakingMode != StakingModeDelegations {
+		return fmt.Errorf("unknown staking mode %q", o.StakingMode)
+	}
+
+	switch o.UnbondingPolicy {
+	case UnbondingPolicyLiquid, UnbondingPolicyVesting:
+	case UnbondingPolicyUnbonding:
+		if o.StakingMode != StakingModeDelegations {
+			return errors.New("--unbonding=unbonding requires --staking=delegations")
+		}
+	default:
+		return fmt.Errorf("unknown unbonding policy %q", o.UnbondingPolicy)
+	}
+
+	switch o.SupplyPolicy {
+	case SupplyPolicyFail, SupplyPolicyRecompute, SupplyPolicyPark:
+	default:
+		return fmt.Errorf("unknown supply policy %q", o.SupplyPolicy)
+	}
+
+	switch o.ModuleAccountPolicy {
+	case ModuleAccountPolicyCommunityPool, ModuleAccountPolicyBurn:
+	case ModuleAccountPolicyRecipient:
+		if o.ModuleAccountRecipient == "" {
+			return errors.New("--module-accounts=recipient requires --module-account-recipient")
+		}
+	default:
+		return fmt.Errorf("unknown module account policy %q", o.ModuleAccountPolicy)
+	}
+
+	if o.Stream {
+		return o.validateStream()
+	}
+
+	return nil
+}
+
+// Check that the options only use steps a streamed migration supports. The
+// balances of a streamed migration are only read back in address order, so
+// steps that look up or change the balance of a single address are not.
+func (o Options) validateStream() error {
+	switch {
+	case o.StakingMode != StakingModeLiquid:
+		return errors.New("--stream requires --staking=liquid")
+	case o.UnbondingPolicy != UnbondingPolicyLiquid:
+		return errors.New("--stream requires --unbonding=liquid")
+	case o.SupplyPolicy == SupplyPolicyPark:
+		return errors.New("--stream cannot be combined with --supply-policy=park")
+	case o.AllocationRules != "":
+		return errors.New("--stream cannot be combined with --allocation-rules")
+	case o.VestingPolicy != "":
+		return errors.New("--stream cannot be combined with --vesting-policy")
+	case o.MergeGenesis != "":
+		return errors.New("--stream cannot be combined with --merge")
+	case o.SpillBuffer < 0:
+		return fmt.Errorf("invalid spill buffer %d", o.SpillBuffer)
+	}
+
+	return nil
+}
+
+// LoadSnapshot reads the snapshot described by manifest.
+//
+// A snapshot given as a CAR archive is unpacked and verified first, and
+// removed again once read. Bad rows are skipped and written to the row error
+// report, unless opts.Strict is set. The returned data still uses source
+// chain addresses and denoms.
+//
+// With opts.Stream the balances are instead converted as they are read and
+// sorted on disk; Close removes them once the genesis has been streamed.
+func LoadSnapshot(manifest *Manifest, opts Options) (*GenesisData, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := manifest.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	genesisTime, err := manifest.genesisTime()
+	if err != nil {
+		return nil, err
+	}
+
+	data := &GenesisData{
+		Manifest:       manifest,
+		Options:        opts,
+		Accounts:       make(map[string]uint64),
+		Balances:       newLedger(),
+		Supply:         make([]Coin, 0),
+		AccountCounter: 0, // Initialize the account counter
+		StakingMode:    opts.StakingMode,
+		ModuleAccounts: make(map[string]string),
+		Validators:     make(map[string]*Validator),
+
+		UnbondingPolicy: opts.UnbondingPolicy,
+		Vesting:         make(map[string]*VestingSchedule),
+
+		GenesisTime: genesisTime,
+
+		RowErrors: &RowErrors{Strict: opts.Strict},
+	}
+
+	// Unpack and verify the snapshot when it is given as a CAR archive
+	cleanup, err := manifest.Input.unpack(data.logf)
+	if err != nil {
+		return nil, fmt.Errorf("error unpacking snapshot: %w", err)
+	}
+	defer cleanup()
+
+	// Sort the balances of a streamed migration on disk
+	if opts.Stream {
+		dir, err := os.MkdirTemp("", "genesis-spill-")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create spill directory: %w", err)
+		}
+		data.spillDir = dir
+		data.Balances = newSpillLedger(manifest, dir, opts.SpillBuffer)
+	}
+
+	// Process files
+	if err := processFiles(data); err != nil {
+		data.Close()
+		return nil, err
+	}
+
+	// Report rows skipped for bad values
+	if err := writeRowErrorReport(data, opts.ReportDir); err != nil {
+		data.Close()
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Convert turns the loaded snapshot into the state of the target chain.
+//
+// Delegations are assigned to validators, addresses and denoms are converted,
+// module account balances are redirected, the allocation rules are applied,
+// the supply is reconciled against the balances, vesting schedules are
+// assigned and denom metadata is generated, writing the report of each step
+// to the report directory.
+func Convert(data *GenesisData) error {
+	opts := data.Options
+
+	// Assign delegations to validators
+	if data.StakingMode == StakingModeDelegations {
+		data.logf("Resolving validators and delegations...\n")
+		if err := resolveStaking(data, opts.ValidatorKeys, opts.ValidatorMap); err != nil {
+			return fmt.Errorf("error resolving staking: %w", err)
+		}
+	}
+
+	// Convert prefixes
+	data.logf("Converting bech32 prefixes from '%s' to '%s'...\n", data.Manifest.Prefixes.Source, data.Manifest.Prefixes.Target)
+	if err := convertPrefixes(data); err != nil {
+		return fmt.Errorf("error converting prefixes: %w", err)
+	}
+
+	// Redirect the balances of module accounts
+	data.logf("Redirecting module account balances...\n")
+	if err := redirectModuleAccounts(data, opts.ModuleAccountPolicy, opts.ModuleAccountRecipient, opts.ReportDir); err != nil {
+		return fmt.Errorf("error redirecting module accounts: %w", err)
+	}
+
+	// Reshape the migrated balances
+	if opts.AllocationRules != "" {
+		data.logf("Applying allocation rules...\n")
+		if err := applyAllocationRules(data, opts.AllocationRules, opts.ReportDir); err != nil {
+			return fmt.Errorf("error applying allocation rules: %w", err)
+		}
+	}
+
+	// Fund the staking pools for the recreated validators
+	if data.StakingMode == StakingModeDelegations {
+		maxValidators, err := stakingMaxValidators(data)
+		if err != nil {
+			return fmt.Errorf("error funding staking pools: %w", err)
+		}
+		if err := fundStakingPools(data, maxValidators); err != nil {
+			return fmt.Errorf("error funding staking pools: %w", err)
+		}
+	}
+
+	// Reconcile supply against balances
+	data.logf("Reconciling supply against balances...\n")
+	if err := reconcileSupply(data, opts.SupplyPolicy, opts.ParkAddress, opts.ReportDir); err != nil {
+		return fmt.Errorf("error reconciling supply: %w", err)
+	}
+
+	// Lock the balances of accounts listed in the vesting policy
+	if opts.VestingPolicy != "" {
+		data.logf("Applying vesting policy...\n")
+		if err := applyVestingPolicy(data, opts.VestingPolicy); err != nil {
+			return fmt.Errorf("error applying vesting policy: %w", err)
+		}
+	}
+
+	// Describe every migrated denom
+	data.logf("Generating denom metadata...\n")
+	metadata, err := buildDenomMetadata(data, opts.DenomMetadata)
+	if err != nil {
+		return err
+	}
+	data.DenomMetadata = metadata
+
+	// Report addresses whose balance was merged from several sources
+	return writeMergeReport(data, opts.ReportDir)
+}
+
+// Build assembles and validates the genesis of the converted data with the
+// modules of an in-memory app configured for the target address prefix.
+//
+// Building the same data twice gives the same genesis, so the result can be
+// saved and its hash published.
+func Build(data *GenesisData) (*genutiltypes.AppGenesis, error) {
+	modules, cleanup, err := newGenesisApp(data.Manifest.Prefixes.Target)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	return BuildWithModules(data, modules)
+}
+
+// BuildWithModules assembles and validates the genesis of the converted data
+// with the modules of an existing app, such as chaind's. The sdk config must
+// already use the target address prefix.
+func BuildWithModules(data *GenesisData, modules Modules) (*genutiltypes.AppGenesis, error) {
+	if data.Balances.spilled() {
+		return nil, errors.New("a streamed migration cannot be built in memory, use StreamGenesis")
+	}
+
+	data.logf("Generating genesis.json...\n")
+	appGenesis, err := generateGenesis(data, modules, data.Options.MergeGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("error generating genesis JSON: %w", err)
+	}
+
+	return appGenesis, nil
+}
+
+// Close removes the balances a streamed migration sorted on disk. It does
+// nothing for other migrations.
+func (d *GenesisData) Close() error {
+	if d.spillDir == "" {
+		return nil
+	}
+
+	return os.RemoveAll(d.spillDir)
+}
+
+// Write progress output to the Log of the options.
+func (d *GenesisData) logf(format string, args ...any) {
+	if d.Options.Log == nil {
+		return
+	}
+
+	fmt.Fprintf(d.Options.Log, format, args...)
+}
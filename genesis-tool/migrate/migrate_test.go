@@ -0,0 +1,219 @@
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The flag is not named -update, which gotest.tools registers in the test
+// binary through the SDK's test utilities.
+var update = flag.Bool("update-golden", false, "rewrite the golden files in testdata")
+
+// The snapshot bundled at the root of the repository, and the balances the
+// default migration gives it, one "address coins" line per account.
+const (
+	bundledSnapshot = "../../QmNyt5bh6KRgPukeH2XScdRnycn4pxHVyAdMKgrHVMktGX"
+	balancesGolden  = "testdata/QmNyt5bh6KRgPukeH2XScdRnycn4pxHVyAdMKgrHVMktGX.balances.golden"
+)
+
+// Load and convert the bundled snapshot with the default migration.
+//
+// The snapshot has no liquid balances, so its supply cannot match and is
+// recomputed from the migrated balances instead, see TestConvertDefaultOptions.
+func convertBundledSnapshot(t *testing.T) *GenesisData {
+	t.Helper()
+
+	manifest := DefaultManifest()
+	manifest.Input.Dir = bundledSnapshot
+	manifest.GenesisTime = "2025-01-01T00:00:00Z"
+
+	opts := DefaultOptions()
+	opts.SupplyPolicy = SupplyPolicyRecompute
+	opts.ReportDir = t.TempDir()
+
+	data, err := LoadSnapshot(manifest, opts)
+	require.NoError(t, err)
+	require.Empty(t, data.RowErrors.Errors)
+
+	require.NoError(t, Convert(data))
+
+	return data
+}
+
+func TestConvertGolden(t *testing.T) {
+	data := convertBundledSnapshot(t)
+
+	var lines strings.Builder
+	for _, address := range data.Balances.addresses() {
+		lines.WriteString(address + " " + data.Balances.balance(address).String() + "\n")
+	}
+
+	if *update {
+		require.NoError(t, os.WriteFile(balancesGolden, []byte(lines.String()), 0o600))
+	}
+
+	golden, err := os.ReadFile(balancesGolden)
+	require.NoError(t, err)
+	require.Equal(t, string(golden), lines.String())
+}
+
+// The bundled snapshot fails the default supply policy, since its supply
+// counts the liquid balances it does not list. The report shows why.
+func TestConvertDefaultOptions(t *testing.T) {
+	manifest := DefaultManifest()
+	manifest.Input.Dir = bundledSnapshot
+	manifest.GenesisTime = "2025-01-01T00:00:00Z"
+
+	opts := DefaultOptions()
+	opts.ReportDir = t.TempDir()
+
+	data, err := LoadSnapshot(manifest, opts)
+	require.NoError(t, err)
+	require.ErrorContains(t, Convert(data), "--supply-policy=recompute or park")
+
+	bz, err := os.ReadFile(filepath.Join(opts.ReportDir, "supply_report.json"))
+	require.NoError(t, err)
+	var report SupplyReport
+	require.NoError(t, json.Unmarshal(bz, &report))
+	require.Equal(t, SupplyPolicyFail, report.Policy)
+	require.Positive(t, report.Mismatched)
+
+	var found bool
+	for _, entry := range report.Denoms {
+		if entry.Denom != "ugadikian" {
+			continue
+		}
+		found = true
+		require.Equal(t, "69000000000000000", entry.Supply)
+		require.False(t, strings.HasPrefix(entry.Difference, "-"), "balances exceed the supply: %s", entry.Difference)
+	}
+	require.True(t, found)
+}
+
+func TestBuildDeterministic(t *testing.T) {
+	data := convertBundledSnapshot(t)
+
+	first, err := Build(data)
+	require.NoError(t, err)
+	second, err := Build(data)
+	require.NoError(t, err)
+
+	require.Equal(t, "gadikian-1", first.ChainID)
+
+	firstJSON, err := json.Marshal(first)
+	require.NoError(t, err)
+	secondJSON, err := json.Marshal(second)
+	require.NoError(t, err)
+	require.Equal(t, string(firstJSON), string(secondJSON))
+}
+
+func TestStreamGenesis(t *testing.T) {
+	manifest := DefaultManifest()
+	manifest.Input.Dir = bundledSnapshot
+	manifest.GenesisTime = "2025-01-01T00:00:00Z"
+
+	opts := DefaultOptions()
+	opts.SupplyPolicy = SupplyPolicyRecompute
+	opts.ReportDir = t.TempDir()
+	opts.Stream = true
+	opts.SpillBuffer = 1000 // Spill the bundled snapshot to several runs
+
+	data, err := LoadSnapshot(manifest, opts)
+	require.NoError(t, err)
+	defer data.Close()
+	require.NoError(t, Convert(data))
+
+	_, err = Build(data)
+	require.ErrorContains(t, err, "use StreamGenesis")
+
+	var genesis bytes.Buffer
+	require.NoError(t, StreamGenesis(data, &genesis))
+
+	// The streamed genesis is valid JSON with the same balances as the golden
+	var streamed struct {
+		AppState struct {
+			Auth struct {
+				Accounts []json.RawMessage `json:"accounts"`
+			} `json:"auth"`
+			Bank struct {
+				Balances []struct {
+					Address string `json:"address"`
+					Coins   []struct {
+						Denom  string `json:"denom"`
+						Amount string `json:"amount"`
+					} `json:"coins"`
+				} `json:"balances"`
+			} `json:"bank"`
+		} `json:"app_state"`
+	}
+	require.NoError(t, json.Unmarshal(genesis.Bytes(), &streamed))
+
+	var lines strings.Builder
+	for _, balance := range streamed.AppState.Bank.Balances {
+		coins := make([]string, 0, len(balance.Coins))
+		for _, coin := range balance.Coins {
+			coins = append(coins, coin.Amount+coin.Denom)
+		}
+		lines.WriteString(balance.Address + " " + strings.Join(coins, ",") + "\n")
+	}
+
+	golden, err := os.ReadFile(balancesGolden)
+	require.NoError(t, err)
+	require.Equal(t, string(golden), lines.String())
+	require.Len(t, streamed.AppState.Auth.Accounts, len(streamed.AppState.Bank.Balances))
+	require.Equal(t, uint64(len(streamed.AppState.Bank.Balances)), data.AccountCounter)
+}
+
+func TestOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		modify func(*Options)
+		err    string
+	}{
+		{name: "defaults", modify: func(*Options) {}},
+		{name: "delegations with unbondings", modify: func(o *Options) {
+			o.StakingMode = StakingModeDelegations
+			o.UnbondingPolicy = UnbondingPolicyUnbonding
+		}},
+		{name: "unknown staking mode", modify: func(o *Options) { o.StakingMode = "bonded" }, err: "unknown staking mode"},
+		{name: "unbondings without delegations", modify: func(o *Options) {
+			o.UnbondingPolicy = UnbondingPolicyUnbonding
+		}, err: "requires --staking=delegations"},
+		{name: "unknown unbonding policy", modify: func(o *Options) { o.UnbondingPolicy = "burn" }, err: "unknown unbonding policy"},
+		{name: "unknown supply policy", modify: func(o *Options) { o.SupplyPolicy = "ignore" }, err: "unknown supply policy"},
+		{name: "stream", modify: func(o *Options) { o.Stream = true }},
+		{name: "stream with delegations", modify: func(o *Options) {
+			o.Stream = true
+			o.StakingMode = StakingModeDelegations
+		}, err: "--stream requires --staking=liquid"},
+		{name: "stream with allocation rules", modify: func(o *Options) {
+			o.Stream = true
+			o.AllocationRules = "rules.yaml"
+		}, err: "cannot be combined with --allocation-rules"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := DefaultOptions()
+			tc.modify(&opts)
+
+			err := opts.Validate()
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package migrate
+
+import (
+	"encoding/csv"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+
+	simapp "github.com/unicorn-research/chain"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// Module account policies.
+const (
+	// ModuleAccountPolicyCommunityPool adds module account balances to the community pool.
+	ModuleAccountPolicyCommunityPool = "community-pool"
+	// ModuleAccountPolicyBurn removes module account balances from the balances and the supply.
+	ModuleAccountPolicyBurn = "burn"
+	// ModuleAccountPolicyRecipient credits module account balances to a designated account.
+	ModuleAccountPolicyRecipient = "recipient"
+)
+
+// moduleAccountSource is the ledger source of the credits and debits made
+// when redirecting module account balances.
+const moduleAccountSource = "--module-accounts"
+
+// ModuleAccountEntry is a module account found in the snapshot.
+type ModuleAccountEntry struct {
+	Address string
+	Module  string
+	// AppModule is set when the app has the same module account, which is
+	// then migrated as a ModuleAccount with the app's permissions.
+	AppModule bool
+	Coins     sdk.Coins // The redirected balance
+}
+
+// Return the module account addresses of the target chain, keyed by address
+// with their module names.
+//
+// They are the accounts of the app's own modules, those of the source chain
+// modules the manifest lists, which are derived from the module name the same
+// way on any chain, and any addresses the manifest lists explicitly.
+func (m *Manifest) moduleAccounts() (map[string]string, error) {
+	names := make([]string, 0, len(m.ModuleAccounts.SourceModules))
+	for name := range simapp.GetMaccPerms() {
+		names = append(names, name)
+	}
+	names = append(names, m.ModuleAccounts.SourceModules...)
+
+	accounts := make(map[string]string, len(names)+len(m.ModuleAccounts.Addresses))
+	for _, name := range names {
+		address, err := m.moduleAddress(name)
+		if err != nil {
+			return nil, err
+		}
+		accounts[address] = name
+	}
+
+	for address, name := range m.ModuleAccounts.Addresses {
+		converted, err := m.convertAddress(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid module_accounts.addresses entry for %s: %w", name, err)
+		}
+		accounts[converted] = name
+	}
+
+	return accounts, nil
+}
+
+// Find the module accounts in the converted snapshot and redirect their
+// balances according to policy.
+//
+// The bank module rejects sends to the app's module accounts, and a module
+// whose account is an ordinary account cannot start, so no migrated balance
+// or base account may be left at a module address. Accounts of the app's own
+// modules are kept as ModuleAccounts with their account numbers; accounts of
+// source chain modules the app does not have are dropped.
+func redirectModuleAccounts(data *GenesisData, policy, recipient, reportDir string) error {
+	accounts, err := data.Manifest.moduleAccounts()
+	if err != nil {
+		return err
+	}
+	perms := simapp.GetMaccPerms()
+
+	// Find them first, since the balances change while redirecting
+	var entries []*ModuleAccountEntry
+	found := make(map[string]bool)
+	err = data.Balances.each(func(address string, coins sdk.Coins, _ []string) error {
+		if name, ok := accounts[address]; ok {
+			entries = append(entries, &ModuleAccountEntry{Address: address, Module: name, Coins: coins})
+			found[address] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for address := range data.Accounts {
+		if name, ok := accounts[address]; ok && !found[address] {
+			entries = append(entries, &ModuleAccountEntry{Address: address, Module: name})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Address < entries[j].Address })
+
+	if len(entries) > 0 && data.Balances.spilled() {
+		return fmt.Errorf("snapshot holds %d module accounts, starting with %s (%s), which a streamed migration cannot redirect", len(entries), entries[0].Address, entries[0].Module)
+	}
+
+	var redirectTo string
+	switch policy {
+	case ModuleAccountPolicyCommunityPool:
+		redirectTo, err = data.Manifest.moduleAddress(distrtypes.ModuleName)
+		if err != nil {
+			return err
+		}
+	case ModuleAccountPolicyBurn:
+	case ModuleAccountPolicyRecipient:
+		redirectTo, err = data.Manifest.convertAddress(recipient)
+		if err != nil {
+			return fmt.Errorf("invalid --module-account-recipient: %w", err)
+		}
+		if name, ok := accounts[redirectTo]; ok {
+			return fmt.Errorf("--module-account-recipient %s is the %s module account", redirectTo, name)
+		}
+	default:
+		return fmt.Errorf("unknown module account policy %q", policy)
+	}
+
+	redirected := sdk.NewCoins()
+	for _, entry := range entries {
+		for _, coin := range entry.Coins {
+			if err := data.Balances.setAmount(entry.Address, moduleAccountSource, coin.Denom, math.ZeroInt()); err != nil {
+				return err
+			}
+		}
+		redirected = redirected.Add(entry.Coins...)
+
+		// An address listed in the manifest may not be the app module's own
+		if _, ok := perms[entry.Module]; ok {
+			address, err := data.Manifest.moduleAddress(entry.Module)
+			if err != nil {
+				return err
+			}
+			entry.AppModule = entry.Address == address
+		}
+		if entry.AppModule {
+			data.ModuleAccounts[entry.Address] = entry.Module
+		} else {
+			delete(data.Accounts, entry.Address)
+		}
+		delete(data.Vesting, entry.Address)
+	}
+
+	if !redirected.IsZero() {
+		switch policy {
+		case ModuleAccountPolicyCommunityPool:
+			if err := data.Balances.add(redirectTo, moduleAccountSource, redirected...); err != nil {
+				return err
+			}
+			data.CommunityPool = data.CommunityPool.Add(redirected...)
+		case ModuleAccountPolicyBurn:
+			if err := burnSupply(data, redirected); err != nil {
+				return err
+			}
+		case ModuleAccountPolicyRecipient:
+			ensureAccount(data, redirectTo)
+			if err := data.Balances.add(redirectTo, moduleAccountSource, redirected...); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := writeModuleAccountReport(entries, policy, redirectTo, reportDir); err != nil {
+		return err
+	}
+
+	data.logf("Found %d module accounts holding %s, redirected with policy %s, see module_accounts.csv\n", len(entries), redirected, policy)
+
+	return nil
+}
+
+// Return the target chain address of a module's account.
+func (m *Manifest) moduleAddress(name string) (string, error) {
+	return sdk.Bech32ifyAddressBytes(m.Prefixes.Target, authtypes.NewModuleAddress(name))
+}
+
+// Remove burned coins from the supply.
+func burnSupply(data *GenesisData, coins sdk.Coins) error {
+	for _, coin := range coins {
+		burned := false
+		for i, supply := range data.Supply {
+			if supply.Denom != coin.Denom {
+				continue
+			}
+
+			amount, err := parseAmount(supply.Amount)
+			if err != nil {
+				return fmt.Errorf("invalid supply of %s: %w", supply.Denom, err)
+			}
+			if amount.Cmp(coin.Amount.BigInt()) < 0 {
+				continue
+			}
+
+			data.Supply[i].Amount = new(big.Int).Sub(amount, coin.Amount.BigInt()).String()
+			burned = true
+			break
+		}
+
+		if !burned {
+			return fmt.Errorf("cannot burn %s from module accounts, the supply holds less", coin)
+		}
+	}
+
+	return nil
+}
+
+// Write every module account found to module_accounts.csv.
+func writeModuleAccountReport(entries []*ModuleAccountEntry, policy, redirectTo, reportDir string) error {
+	file, err := os.Create(filepath.Join(reportDir, "module_accounts.csv"))
+	if err != nil {
+		return fmt.Errorf("failed to create module account report: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	if err := writer.Write([]string{"address", "module", "account", "coins", "policy", "redirected_to"}); err != nil {
+		return fmt.Errorf("failed to write module account report: %w", err)
+	}
+
+	for _, entry := range entries {
+		account := "dropped"
+		if entry.AppModule {
+			account = "module"
+		}
+
+		if err := writer.Write([]string{entry.Address, entry.Module, account, entry.Coins.String(), policy, redirectTo}); err != nil {
+			return fmt.Errorf("failed to write module account report: %w", err)
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
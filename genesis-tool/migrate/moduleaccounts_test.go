@@ -0,0 +1,108 @@
+package migrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestRedirectModuleAccounts(t *testing.T) {
+	t.Parallel()
+
+	const (
+		holder    = "gadikian1qqyl24rxge02cgkqnq4p2340s28kdd899g2kmn"
+		recipient = "unicorn1rn9f6ack3u8t3ed04pfaqpmh5zfp2m2ll4mkty"
+	)
+
+	manifest := DefaultManifest()
+	feeCollector, err := manifest.moduleAddress(authtypes.FeeCollectorName)
+	require.NoError(t, err)
+	bondedPool, err := manifest.moduleAddress(stakingtypes.BondedPoolName)
+	require.NoError(t, err)
+	tokenFactory, err := manifest.moduleAddress("tokenfactory")
+	require.NoError(t, err)
+	communityPool, err := manifest.moduleAddress(distrtypes.ModuleName)
+	require.NoError(t, err)
+	recipientAddress, err := manifest.convertAddress(recipient)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		policy   string
+		expected map[string]string // Balances after redirecting
+		supply   string
+		pool     string
+	}{
+		{
+			name:   "community pool",
+			policy: ModuleAccountPolicyCommunityPool,
+			expected: map[string]string{
+				holder:        "100ugadikian",
+				communityPool: "5ubear,60ugadikian",
+			},
+			supply: "5ubear,160ugadikian",
+			pool:   "5ubear,60ugadikian",
+		},
+		{
+			name:   "burn",
+			policy: ModuleAccountPolicyBurn,
+			expected: map[string]string{
+				holder: "100ugadikian",
+			},
+			supply: "100ugadikian",
+		},
+		{
+			name:   "recipient",
+			policy: ModuleAccountPolicyRecipient,
+			expected: map[string]string{
+				holder:           "100ugadikian",
+				recipientAddress: "5ubear,60ugadikian",
+			},
+			supply: "5ubear,160ugadikian",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := &GenesisData{
+				Manifest:       manifest,
+				Accounts:       map[string]uint64{holder: 0, feeCollector: 1, bondedPool: 2, tokenFactory: 3},
+				Balances:       newLedger(),
+				Supply:         []Coin{{Denom: "ubear", Amount: "5"}, {Denom: "ugadikian", Amount: "160"}},
+				ModuleAccounts: make(map[string]string),
+				Vesting:        make(map[string]*VestingSchedule),
+				AccountCounter: 4,
+			}
+			require.NoError(t, data.Balances.addAmount(holder, "balances.csv", "ugadikian", math.NewInt(100)))
+			require.NoError(t, data.Balances.addAmount(feeCollector, "balances.csv", "ugadikian", math.NewInt(40)))
+			require.NoError(t, data.Balances.addAmount(bondedPool, "balances.csv", "ugadikian", math.NewInt(20)))
+			require.NoError(t, data.Balances.addAmount(tokenFactory, "balances.csv", "ubear", math.NewInt(5)))
+
+			require.NoError(t, redirectModuleAccounts(data, tc.policy, recipient, t.TempDir()))
+
+			balances := make(map[string]string)
+			for _, address := range data.Balances.addresses() {
+				balances[address] = data.Balances.balance(address).String()
+			}
+			require.Equal(t, tc.expected, balances)
+
+			supply, err := toSDKCoins(data.Supply)
+			require.NoError(t, err)
+			require.Equal(t, tc.supply, supply.String())
+			require.Equal(t, tc.pool, data.CommunityPool.String())
+
+			// App module accounts stay as module accounts, others are dropped
+			require.Equal(t, map[string]string{feeCollector: authtypes.FeeCollectorName, bondedPool: stakingtypes.BondedPoolName}, data.ModuleAccounts)
+			require.Contains(t, data.Accounts, bondedPool)
+			require.NotContains(t, data.Accounts, tokenFactory)
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package migrate
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Supply reconciliation policies.
+const (
+	// SupplyPolicyFail aborts when supply.csv does not match the sum of balances.
+	SupplyPolicyFail = "fail"
+	// SupplyPolicyRecompute replaces the supply with the sum of balances.
+	SupplyPolicyRecompute = "recompute"
+	// SupplyPolicyPark credits any unallocated supply to a designated account.
+	SupplyPolicyPark = "park"
+)
+
+// SupplyDiff is the reconciliation result for a single denom.
+type SupplyDiff struct {
+	Denom    string `json:"denom"`
+	Supply   string `json:"supply"`
+	Balances string `json:"balances"`
+	// Difference is supply minus balances; positive means supply is unallocated.
+	Difference string `json:"difference"`
+}
+
+// SupplyReport is the per-denom reconciliation of supply.csv against the genesis balances.
+type SupplyReport struct {
+	Policy     string       `json:"policy"`
+	Mismatched int          `json:"mismatched"`
+	Denoms     []SupplyDiff `json:"denoms"`
+}
+
+// Reconcile the supply against the sum of all balances and apply the policy.
+//
+// Balances include liquid, bonded and LP-redeemed amounts as well as any
+// module account balances, since all of them are credited to data.Balances.
+// The report is always written to reportDir before the policy is applied, so
+// it is available even when reconciliation fails.
+func reconcileSupply(data *GenesisData, policy, parkAddress, reportDir string) error {
+	supply, err := sumCoins(data.Supply)
+	if err != nil {
+		return fmt.Errorf("invalid supply: %w", err)
+	}
+
+	balances := make(map[string]*big.Int)
+	for _, coin := range data.Balances.total() {
+		balances[coin.Denom] = coin.Amount.BigInt()
+	}
+
+	report := buildSupplyReport(supply, balances, policy)
+	if err := writeSupplyReport(report, reportDir); err != nil {
+		return err
+	}
+
+	data.logf("Supply reconciliation: %d of %d denoms mismatched\n", report.Mismatched, len(report.Denoms))
+	if report.Mismatched == 0 {
+		return nil
+	}
+
+	switch policy {
+	case SupplyPolicyFail:
+		return fmt.Errorf("supply does not match balances for %d denoms, see supply_report.csv; migrate with --supply-policy=recompute or park to reconcile it", report.Mismatched)
+	case SupplyPolicyRecompute:
+		data.Supply = coinsFromTotals(balances)
+		data.logf("Recomputed supply from balances\n")
+		return nil
+	case SupplyPolicyPark:
+		return parkSupplyDifference(data, report, parkAddress)
+	default:
+		return fmt.Errorf("unknown supply policy %q", policy)
+	}
+}
+
+// Build the per-denom report covering every denom in either the supply or the balances.
+func buildSupplyReport(supply, balances map[string]*big.Int, policy string) *SupplyReport {
+	denomSet := make(map[string]struct{}, len(supply))
+	for denom := range supply {
+		denomSet[denom] = struct{}{}
+	}
+	for denom := range balances {
+		denomSet[denom] = struct{}{}
+	}
+
+	denoms := make([]string, 0, len(denomSet))
+	for denom := range denomSet {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	report := &SupplyReport{
+		Policy: policy,
+		Denoms: make([]SupplyDiff, 0, len(denoms)),
+	}
+
+	for _, denom := range denoms {
+		s := amountOrZero(supply, denom)
+		b := amountOrZero(balances, denom)
+		diff := new(big.Int).Sub(s, b)
+
+		if diff.Sign() != 0 {
+			report.Mismatched++
+		}
+
+		report.Denoms = append(report.Denoms, SupplyDiff{
+			Denom:      denom,
+			Supply:     s.String(),
+			Balances:   b.String(),
+			Difference: diff.String(),
+		})
+	}
+
+	return report
+}
+
+// Credit each denom's unallocated supply to parkAddress, which may be given
+// with either prefix.
+//
+// Only a surplus can be parked; balances that exceed the supply mean the
+// snapshot holds more tokens than ever existed, which is always an error.
+func parkSupplyDifference(data *GenesisData, report *SupplyReport, parkAddress string) error {
+	if parkAddress == "" {
+		return errors.New("supply policy park requires --park-address")
+	}
+
+	address, err := data.Manifest.convertAddress(parkAddress)
+	if err != nil {
+		return fmt.Errorf("invalid --park-address: %w", err)
+	}
+
+	var parked []sdk.Coin
+	for _, entry := range report.Denoms {
+		diff, _ := new(big.Int).SetString(entry.Difference, 10)
+		switch diff.Sign() {
+		case 0:
+			continue
+		case -1:
+			return fmt.Errorf("balances exceed supply of %s by %s, cannot park a negative difference", entry.Denom, diff.Neg(diff))
+		}
+
+		parked = append(parked, sdk.Coin{Denom: entry.Denom, Amount: math.NewIntFromBigInt(diff)})
+	}
+
+	ensureAccount(data, address)
+	if err := data.Balances.add(address, "--park-address", parked...); err != nil {
+		return err
+	}
+
+	data.logf("Parked unallocated supply of %d denoms in %s\n", len(parked), address)
+
+	return nil
+}
+
+// Write the report as supply_report.csv and supply_report.json.
+func writeSupplyReport(report *SupplyReport, reportDir string) error {
+	reportJSON, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal supply report: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(reportDir, "supply_report.json"), reportJSON, 0o600); err != nil {
+		return fmt.Errorf("failed to write supply report: %w", err)
+	}
+
+	file, err := os.Create(filepath.Join(reportDir, "supply_report.csv"))
+	if err != nil {
+		return fmt.Errorf("failed to create supply report: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	if err := writer.Write([]string{"denom", "supply", "balances", "difference"}); err != nil {
+		return fmt.Errorf("failed to write supply report: %w", err)
+	}
+
+	for _, entry := range report.Denoms {
+		if err := writer.Write([]string{entry.Denom, entry.Supply, entry.Balances, entry.Difference}); err != nil {
+			return fmt.Errorf("failed to write supply report: %w", err)
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// Sum a list of coins by denom.
+func sumCoins(coins []Coin) (map[string]*big.Int, error) {
+	totals := make(map[string]*big.Int)
+	for _, coin := range coins {
+		amount, err := parseAmount(coin.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount for %s: %w", coin.Denom, err)
+		}
+		addAmount(totals, coin.Denom, amount)
+	}
+
+	return totals, nil
+}
+
+// Convert per-denom totals into coins sorted by denom, dropping zero amounts.
+func coinsFromTotals(totals map[string]*big.Int) []Coin {
+	denoms := make([]string, 0, len(totals))
+	for denom, amount := range totals {
+		if amount.Sign() != 0 {
+			denoms = append(denoms, denom)
+		}
+	}
+	sort.Strings(denoms)
+
+	coins := make([]Coin, 0, len(denoms))
+	for _, denom := range denoms {
+		coins = append(coins, Coin{Denom: denom, Amount: totals[denom].String()})
+	}
+
+	return coins
+}
+
+// Return the total for denom, or zero if there is none.
+func amountOrZero(totals map[string]*big.Int, denom string) *big.Int {
+	if amount, ok := totals[denom]; ok {
+		return amount
+	}
+
+	return new(big.Int)
+}
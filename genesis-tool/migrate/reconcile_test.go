@@ -0,0 +1,132 @@
+package migrate
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestReconcileSupply(t *testing.T) {
+	t.Parallel()
+
+	const (
+		holder = "gadikian1qqyl24rxge02cgkqnq4p2340s28kdd899g2kmn"
+		park   = "unicorn1rn9f6ack3u8t3ed04pfaqpmh5zfp2m2ll4mkty"
+	)
+
+	manifest := DefaultManifest()
+	parkAddress, err := manifest.convertAddress(park)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		supply      []Coin
+		policy      string
+		parkAddress string
+		mismatched  int
+		expected    map[string]string // Balances after reconciling
+		newSupply   string
+		err         string
+	}{
+		{
+			name:      "matching supply",
+			supply:    []Coin{{Denom: "ugadikian", Amount: "100"}},
+			policy:    SupplyPolicyFail,
+			expected:  map[string]string{holder: "100ugadikian"},
+			newSupply: "100ugadikian",
+		},
+		{
+			name:       "fail",
+			supply:     []Coin{{Denom: "ugadikian", Amount: "150"}},
+			policy:     SupplyPolicyFail,
+			mismatched: 1,
+			err:        "supply does not match balances for 1 denoms",
+		},
+		{
+			// Denoms no balance holds are dropped from the supply
+			name:       "recompute",
+			supply:     []Coin{{Denom: "ubear", Amount: "5"}, {Denom: "ugadikian", Amount: "150"}},
+			policy:     SupplyPolicyRecompute,
+			mismatched: 2,
+			expected:   map[string]string{holder: "100ugadikian"},
+			newSupply:  "100ugadikian",
+		},
+		{
+			name:        "park",
+			supply:      []Coin{{Denom: "ubear", Amount: "5"}, {Denom: "ugadikian", Amount: "150"}},
+			policy:      SupplyPolicyPark,
+			parkAddress: park,
+			mismatched:  2,
+			expected:    map[string]string{holder: "100ugadikian", parkAddress: "5ubear,50ugadikian"},
+			newSupply:   "5ubear,150ugadikian",
+		},
+		{
+			name:       "park without address",
+			supply:     []Coin{{Denom: "ugadikian", Amount: "150"}},
+			policy:     SupplyPolicyPark,
+			mismatched: 1,
+			err:        "requires --park-address",
+		},
+		{
+			name:        "park negative difference",
+			supply:      []Coin{{Denom: "ugadikian", Amount: "80"}},
+			policy:      SupplyPolicyPark,
+			parkAddress: park,
+			mismatched:  1,
+			err:         "balances exceed supply of ugadikian by 20",
+		},
+		{
+			name:       "unknown policy",
+			supply:     []Coin{{Denom: "ugadikian", Amount: "150"}},
+			policy:     "ignore",
+			mismatched: 1,
+			err:        "unknown supply policy",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := &GenesisData{
+				Manifest: manifest,
+				Accounts: map[string]uint64{holder: 0},
+				Balances: newLedger(),
+				Supply:   tc.supply,
+			}
+			require.NoError(t, data.Balances.addAmount(holder, "balances.csv", "ugadikian", math.NewInt(100)))
+
+			reportDir := t.TempDir()
+			err := reconcileSupply(data, tc.policy, tc.parkAddress, reportDir)
+
+			// The report is written whatever the policy does
+			bz, readErr := os.ReadFile(filepath.Join(reportDir, "supply_report.json"))
+			require.NoError(t, readErr)
+			var report SupplyReport
+			require.NoError(t, json.Unmarshal(bz, &report))
+			require.Equal(t, tc.policy, report.Policy)
+			require.Equal(t, tc.mismatched, report.Mismatched)
+
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			balances := make(map[string]string)
+			for _, address := range data.Balances.addresses() {
+				balances[address] = data.Balances.balance(address).String()
+			}
+			require.Equal(t, tc.expected, balances)
+
+			supply, err := toSDKCoins(data.Supply)
+			require.NoError(t, err)
+			require.Equal(t, tc.newSupply, supply.String())
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package migrate
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"cosmossdk.io/math"
+)
+
+// RowError is a bad value in a snapshot file.
+type RowError struct {
+	File   string `json:"file"`
+	Row    int    `json:"row"` // Line number in the file, starting at 1 for the header
+	Column string `json:"column"`
+	Value  string `json:"value"`
+	Reason string `json:"reason"`
+}
+
+// Error implements the error interface.
+func (e *RowError) Error() string {
+	return fmt.Sprintf("%s:%d: column %s: %q: %s", e.File, e.Row, e.Column, e.Value, e.Reason)
+}
+
+// RowErrors collects the bad rows found while reading the snapshot.
+//
+// In strict mode the first bad row aborts the migration. Otherwise bad rows
+// are skipped, so they contribute nothing to the genesis, and are listed in
+// the row error report.
+type RowErrors struct {
+	Strict bool
+	Errors []*RowError
+}
+
+// Report a bad row.
+//
+// In strict mode the row error is returned so the caller stops; otherwise it
+// is recorded and nil is returned, and the caller skips the row.
+func (r *RowErrors) report(rowErr *RowError) error {
+	if r.Strict {
+		return rowErr
+	}
+
+	r.Errors = append(r.Errors, rowErr)
+
+	return nil
+}
+
+// Parse an amount column as a non-negative math.Int, treating an empty value as zero.
+//
+// Only plain decimal digits are accepted, so signs, exponents, decimals and
+// surrounding whitespace are all rejected rather than interpreted.
+func parseAmountField(file string, row int, column, value string) (math.Int, *RowError) {
+	if value == "" {
+		return math.ZeroInt(), nil
+	}
+
+	rowErr := &RowError{File: file, Row: row, Column: column, Value: value}
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			rowErr.Reason = "amount must be a non-negative integer"
+			return math.Int{}, rowErr
+		}
+	}
+
+	amount, ok := math.NewIntFromString(value)
+	if !ok {
+		rowErr.Reason = "amount exceeds 256 bits"
+		return math.Int{}, rowErr
+	}
+
+	return amount, nil
+}
+
+// Write the skipped rows to row_errors.csv and row_errors.json.
+func writeRowErrorReport(data *GenesisData, reportDir string) error {
+	rowErrors := data.RowErrors
+
+	errorsJSON, err := json.MarshalIndent(rowErrors.Errors, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal row error report: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(reportDir, "row_errors.json"), errorsJSON, 0o600); err != nil {
+		return fmt.Errorf("failed to write row error report: %w", err)
+	}
+
+	file, err := os.Create(filepath.Join(reportDir, "row_errors.csv"))
+	if err != nil {
+		return fmt.Errorf("failed to create row error report: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	if err := writer.Write([]string{"file", "row", "column", "value", "reason"}); err != nil {
+		return fmt.Errorf("failed to write row error report: %w", err)
+	}
+
+	for _, rowErr := range rowErrors.Errors {
+		record := []string{rowErr.File, strconv.Itoa(rowErr.Row), rowErr.Column, rowErr.Value, rowErr.Reason}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write row error report: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write row error report: %w", err)
+	}
+
+	if len(rowErrors.Errors) > 0 {
+		data.logf("Warning: skipped %d bad rows, see row_errors.csv\n", len(rowErrors.Errors))
+	}
+
+	return nil
+}
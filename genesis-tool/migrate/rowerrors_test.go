@@ -0,0 +1,63 @@
+package migrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAmountField(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value  string
+		amount string
+		reason string
+	}{
+		{value: "", amount: "0"},
+		{value: "0", amount: "0"},
+		{value: "22900000000", amount: "22900000000"},
+		{value: "007", amount: "7"},
+		{value: "-1", reason: "non-negative integer"},
+		{value: "+1", reason: "non-negative integer"},
+		{value: "1.5", reason: "non-negative integer"},
+		{value: "1e6", reason: "non-negative integer"},
+		{value: " 1", reason: "non-negative integer"},
+		{value: "115792089237316195423570985008687907853269984665640564039457584007913129639935", amount: "115792089237316195423570985008687907853269984665640564039457584007913129639935"},
+		{value: "115792089237316195423570985008687907853269984665640564039457584007913129639936", reason: "exceeds 256 bits"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			t.Parallel()
+
+			amount, rowErr := parseAmountField("kaway_bond.csv", 2, "uwu", tc.value)
+			if tc.reason == "" {
+				require.Nil(t, rowErr)
+				require.Equal(t, tc.amount, amount.String())
+				return
+			}
+
+			require.NotNil(t, rowErr)
+			require.Equal(t, "kaway_bond.csv", rowErr.File)
+			require.Equal(t, 2, rowErr.Row)
+			require.Equal(t, "uwu", rowErr.Column)
+			require.Equal(t, tc.value, rowErr.Value)
+			require.Contains(t, rowErr.Reason, tc.reason)
+		})
+	}
+}
+
+func TestRowErrorsReport(t *testing.T) {
+	t.Parallel()
+
+	rowErr := &RowError{File: "supply.csv", Row: 3, Column: "amount", Value: "x", Reason: "amount must be a non-negative integer"}
+
+	lenient := &RowErrors{}
+	require.NoError(t, lenient.report(rowErr))
+	require.Equal(t, []*RowError{rowErr}, lenient.Errors)
+
+	strict := &RowErrors{Strict: true}
+	require.EqualError(t, strict.report(rowErr), `supply.csv:3: column amount: "x": amount must be a non-negative integer`)
+	require.Empty(t, strict.Errors)
+}
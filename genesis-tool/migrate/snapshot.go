@@ -0,0 +1,399 @@
+package migrate
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// processFiles processes all CSV files and populates the genesis data.
+func processFiles(data *GenesisData) error {
+	input := data.Manifest.Input
+
+	// Process balances.csv if it exists
+	data.logf("Processing %s...\n", input.Balances)
+	if input.exists(input.Balances) {
+		if err := processBalances(input.path(input.Balances), data); err != nil {
+			return fmt.Errorf("error processing balances: %w", err)
+		}
+	} else {
+		data.logf("%s not found, skipping...\n", input.Balances)
+	}
+
+	// Process supply.csv
+	data.logf("Processing %s...\n", input.Supply)
+	if err := processSupply(input.path(input.Supply), data); err != nil {
+		return fmt.Errorf("error processing supply: %w", err)
+	}
+
+	// Process bonds
+	if data.StakingMode == StakingModeDelegations {
+		if err := processStakingFiles(data); err != nil {
+			return err
+		}
+	} else if err := processLiquidBonds(data); err != nil {
+		return err
+	}
+
+	// Process unbonding entries
+	if err := processUnbondings(data); err != nil {
+		return err
+	}
+
+	// Process LP files
+	data.logf("Processing liquidity pool data...\n")
+	if err := processLPs(data); err != nil {
+		return fmt.Errorf("error processing liquidity pools: %w", err)
+	}
+
+	return nil
+}
+
+// Process kaway_bond.csv and uwuval_bond.csv as liquid balances.
+func processLiquidBonds(data *GenesisData) error {
+	input := data.Manifest.Input
+
+	// Process kaway_bond.csv
+	data.logf("Processing %s...\n", input.KawayBond)
+	if err := processBonds(input.path(input.KawayBond), BondDenom, data); err != nil {
+		return fmt.Errorf("error processing kaway_bond: %w", err)
+	}
+
+	// Process uwuval_bond.csv if it exists
+	data.logf("Processing %s...\n", input.UwuvalBond)
+	if input.exists(input.UwuvalBond) {
+		if err := processBonds(input.path(input.UwuvalBond), BondDenom, data); err != nil {
+			return fmt.Errorf("error processing uwuval_bond: %w", err)
+		}
+	} else {
+		data.logf("%s not found, skipping...\n", input.UwuvalBond)
+	}
+
+	return nil
+}
+
+// Convert source chain addresses and denoms to the target chain.
+func convertPrefixes(data *GenesisData) error {
+	// Convert account addresses
+	convertedAccounts := make(map[string]uint64, len(data.Accounts))
+	for oldAddress, accountNumber := range data.Accounts {
+		newAddress, err := data.Manifest.convertAddress(oldAddress)
+		if err != nil {
+			return err
+		}
+		convertedAccounts[newAddress] = accountNumber
+	}
+	data.Accounts = convertedAccounts
+
+	// Convert balances addresses and denoms
+	convertedBalances, err := data.Balances.convert(data.Manifest)
+	if err != nil {
+		return err
+	}
+	data.Balances = convertedBalances
+
+	// Convert supply denoms
+	for i, coin := range data.Supply {
+		denom, err := data.Manifest.convertDenom(coin.Denom)
+		if err != nil {
+			return err
+		}
+
+		data.Supply[i] = Coin{
+			Denom:  denom,
+			Amount: coin.Amount,
+		}
+	}
+
+	// Convert validator, delegation and unbonding addresses
+	if err := convertStakingPrefixes(data); err != nil {
+		return err
+	}
+
+	// Convert vesting addresses and denoms
+	if err := convertVestingPrefixes(data); err != nil {
+		return err
+	}
+
+	// Debug output
+	if !data.Balances.spilled() {
+		data.logf("Converted %d account addresses\n", len(data.Accounts))
+		data.logf("Converted %d balance entries\n", len(data.Balances.addresses()))
+	}
+	data.logf("Converted %d supply entries\n", len(data.Supply))
+
+	return nil
+}
+
+// Process bonds from a CSV file (kaway_bond.csv or uwuval_bond.csv).
+func processBonds(filePath, denom string, data *GenesisData) error {
+	// Check if file exists
+	if _, err := os.Stat(filePath); err != nil {
+		data.logf("%s not found, skipping...\n", filepath.Base(filePath))
+		return nil
+	}
+
+	// Open the CSV file
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", filepath.Base(filePath), err)
+	}
+	defer file.Close()
+
+	// Create a new CSV reader
+	reader := csv.NewReader(file)
+
+	// Read the header
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("failed to read header: %w", err)
+	}
+
+	// Expected header: address,uwu or similar
+	if len(header) < 2 || header[0] != "address" {
+		return fmt.Errorf("unexpected header format in %s, expected: address,amount", filepath.Base(filePath))
+	}
+
+	return processCsvRows(reader, filepath.Base(filePath), header, data, denom)
+}
+
+// Process CSV rows to extract bond data.
+func processCsvRows(reader *csv.Reader, fileName string, header []string, data *GenesisData, denom string) error {
+	// Process rows
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error reading row: %w", err)
+		}
+
+		// Parse data
+		line := recordLine(reader)
+		address := row[0]
+
+		// Parse amount
+		coinAmount, rowErr := parseAmountField(fileName, line, header[1], row[1])
+		if rowErr != nil {
+			if err := data.RowErrors.report(rowErr); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Skip empty amounts
+		if coinAmount.IsZero() {
+			continue
+		}
+
+		// Validate address
+		if rowErr := data.Manifest.checkAddress(fileName, line, header[0], address); rowErr != nil {
+			if err := data.RowErrors.report(rowErr); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Ensure account exists
+		ensureAccount(data, address)
+
+		// Add coin to balances
+		if err := data.Balances.addAmount(address, fileName, denom, coinAmount); err != nil {
+			return fmt.Errorf("%s:%d: %w", fileName, line, err)
+		}
+	}
+
+	return nil
+}
+
+// Create an account for address with the next account number, unless it already exists.
+//
+// A streamed migration numbers its accounts as it writes them instead.
+func ensureAccount(data *GenesisData, address string) {
+	if data.Balances.spilled() {
+		return
+	}
+	if _, exists := data.Accounts[address]; exists {
+		return
+	}
+
+	data.Accounts[address] = data.AccountCounter
+	data.AccountCounter++
+}
+
+// Process balances.csv - expected to be complex with many columns.
+func processBalances(filePath string, data *GenesisData) error {
+	// Open the CSV file
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", filepath.Base(filePath), err)
+	}
+	defer file.Close()
+
+	// Create a new CSV reader
+	reader := csv.NewReader(file)
+
+	// Read the header to determine columns
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("failed to read header: %w", err)
+	}
+
+	// First column should be 'address'
+	if header[0] != "address" {
+		return fmt.Errorf("unexpected header format in %s, first column should be 'address'", filepath.Base(filePath))
+	}
+
+	// Remaining columns are denoms; a column with a bad denom is skipped entirely
+	skipColumns := make(map[int]bool)
+	for i, denom := range header[1:] {
+		rowErr := data.Manifest.checkDenom(filepath.Base(filePath), recordLine(reader), "header", denom)
+		if rowErr == nil {
+			continue
+		}
+		if err := data.RowErrors.report(rowErr); err != nil {
+			return err
+		}
+		skipColumns[i+1] = true
+	}
+
+	return processBalanceRows(reader, filepath.Base(filePath), header, skipColumns, data)
+}
+
+// Process balance rows from CSV.
+func processBalanceRows(reader *csv.Reader, fileName string, header []string, skipColumns map[int]bool, data *GenesisData) error {
+	// Process rows
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error reading row: %w", err)
+		}
+
+		line := recordLine(reader)
+		if rowErr := data.Manifest.checkAddress(fileName, line, header[0], row[0]); rowErr != nil {
+			if err := data.RowErrors.report(rowErr); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := processBalanceRow(row, fileName, line, header, skipColumns, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Process a single balance row.
+//
+// The row is only credited if every amount in it parses, so a skipped row
+// leaves no partial balance behind.
+func processBalanceRow(row []string, fileName string, line int, header []string, skipColumns map[int]bool, data *GenesisData) error {
+	// Parse address
+	address := row[0]
+
+	// Parse balances for each denom in the header
+	var coins []sdk.Coin
+	for i := 1; i < len(header) && i < len(row); i++ {
+		if skipColumns[i] {
+			continue
+		}
+
+		coinAmount, rowErr := parseAmountField(fileName, line, header[i], row[i])
+		if rowErr != nil {
+			return data.RowErrors.report(rowErr)
+		}
+
+		// Skip empty amounts
+		if coinAmount.IsZero() {
+			continue
+		}
+
+		coins = append(coins, sdk.Coin{Denom: header[i], Amount: coinAmount})
+	}
+
+	// Ensure account exists
+	ensureAccount(data, address)
+
+	// Add to balances
+	if len(coins) > 0 {
+		if err := data.Balances.add(address, fileName, coins...); err != nil {
+			return fmt.Errorf("%s:%d: %w", fileName, line, err)
+		}
+	}
+
+	return nil
+}
+
+// Process supply.csv.
+func processSupply(filePath string, data *GenesisData) error {
+	// Open the CSV file
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", filepath.Base(filePath), err)
+	}
+	defer file.Close()
+
+	// Create a new CSV reader
+	reader := csv.NewReader(file)
+
+	// Read the header
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("failed to read header: %w", err)
+	}
+
+	// Expected header: denom,amount
+	if len(header) < 2 || header[0] != "denom" || header[1] != "amount" {
+		return fmt.Errorf("unexpected header format in %s, expected: denom,amount", filepath.Base(filePath))
+	}
+
+	// Process rows
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error reading row: %w", err)
+		}
+
+		// Parse data
+		line := recordLine(reader)
+		denom := row[0]
+
+		// Validate denom
+		if rowErr := data.Manifest.checkDenom(filepath.Base(filePath), line, header[0], denom); rowErr != nil {
+			if err := data.RowErrors.report(rowErr); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Parse amount
+		amount, rowErr := parseAmountField(filepath.Base(filePath), line, header[1], row[1])
+		if rowErr != nil {
+			if err := data.RowErrors.report(rowErr); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Add to supply, keeping the amount in canonical form
+		data.Supply = append(data.Supply, Coin{
+			Denom:  denom,
+			Amount: amount.String(),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package migrate
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+// DefaultSpillBuffer is the number of credits a streamed migration holds in
+// memory before sorting them into a run on disk.
+const DefaultSpillBuffer = 1 << 20
+
+// spillEntry is a single coin credited to an address, as sorted on disk.
+type spillEntry struct {
+	Address string
+	Credit  uint64 // Sequence number of the credit the coin was part of
+	Source  string
+	Denom   string
+	Amount  string
+}
+
+// Less orders entries by address, then by the order they were credited in.
+func (e spillEntry) Less(other spillEntry) bool {
+	if e.Address != other.Address {
+		return e.Address < other.Address
+	}
+	return e.Credit < other.Credit
+}
+
+// spillSorter is an external merge sort of ledger entries.
+//
+// Entries are buffered in memory up to a limit, then sorted and written to a
+// run file. Merging reads all runs at once and yields the entries of each
+// address together, so memory stays bounded by the buffer and the number of
+// runs rather than by the number of addresses.
+type spillSorter struct {
+	dir    string
+	limit  int
+	buffer []spillEntry
+	runs   []string
+}
+
+// Create a spill sorter writing its runs to dir, holding up to limit entries in memory.
+func newSpillSorter(dir string, limit int) *spillSorter {
+	if limit <= 0 {
+		limit = DefaultSpillBuffer
+	}
+
+	return &spillSorter{dir: dir, limit: limit}
+}
+
+// Add an entry, writing a run when the buffer is full.
+func (s *spillSorter) add(entry spillEntry) error {
+	s.buffer = append(s.buffer, entry)
+	if len(s.buffer) < s.limit {
+		return nil
+	}
+
+	return s.flush()
+}
+
+// Sort the buffered entries and write them to a new run.
+func (s *spillSorter) flush() error {
+	if len(s.buffer) == 0 {
+		return nil
+	}
+
+	// Stable, so the coins of a credit stay in the order they were added
+	sort.SliceStable(s.buffer, func(i, j int) bool { return s.buffer[i].Less(s.buffer[j]) })
+
+	path := filepath.Join(s.dir, fmt.Sprintf("run-%06d.csv", len(s.runs)))
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create spill run: %w", err)
+	}
+	defer file.Close()
+
+	buffered := bufio.NewWriter(file)
+	writer := csv.NewWriter(buffered)
+	for _, entry := range s.buffer {
+		record := []string{entry.Address, strconv.FormatUint(entry.Credit, 10), entry.Source, entry.Denom, entry.Amount}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write spill run: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write spill run: %w", err)
+	}
+	if err := buffered.Flush(); err != nil {
+		return fmt.Errorf("failed to write spill run: %w", err)
+	}
+
+	s.runs = append(s.runs, path)
+	s.buffer = s.buffer[:0]
+
+	return nil
+}
+
+// Merge the runs, calling fn with the entries of each address in address
+// order. The entries of an address are in the order they were added, and only
+// valid until fn returns.
+//
+// Merging can be repeated; entries added since the last merge are included.
+func (s *spillSorter) merge(fn func(address string, entries []spillEntry) error) error {
+	if err := s.flush(); err != nil {
+		return err
+	}
+
+	runs := make(spillRuns, 0, len(s.runs))
+	defer func() {
+		for _, run := range runs {
+			run.file.Close()
+		}
+	}()
+
+	for i, path := range s.runs {
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("failed to open spill run: %w", err)
+		}
+
+		run := &spillRun{index: i, file: file, reader: csv.NewReader(bufio.NewReader(file))}
+		run.reader.FieldsPerRecord = 5
+		runs = append(runs, run)
+	}
+
+	// Prime the heap with the first entry of every run
+	active := make(spillRuns, 0, len(runs))
+	for _, run := range runs {
+		ok, err := run.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			active = append(active, run)
+		}
+	}
+	heap.Init(&active)
+
+	var (
+		address string
+		entries []spillEntry
+	)
+	for active.Len() > 0 {
+		run := active[0]
+		entry := run.entry
+
+		if entry.Address != address && len(entries) > 0 {
+			if err := fn(address, entries); err != nil {
+				return err
+			}
+			entries = entries[:0]
+		}
+		address = entry.Address
+		entries = append(entries, entry)
+
+		ok, err := run.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Fix(&active, 0)
+		} else {
+			heap.Pop(&active)
+		}
+	}
+
+	if len(entries) > 0 {
+		return fn(address, entries)
+	}
+
+	return nil
+}
+
+// spillRun reads back a single sorted run.
+type spillRun struct {
+	index  int // Position of the run, breaking ties between equal entries
+	file   *os.File
+	reader *csv.Reader
+	entry  spillEntry
+}
+
+// Read the next entry of the run, returning false at its end.
+func (r *spillRun) next() (bool, error) {
+	record, err := r.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read spill run %s: %w", filepath.Base(r.file.Name()), err)
+	}
+
+	credit, err := strconv.ParseUint(record[1], 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("failed to read spill run %s: %w", filepath.Base(r.file.Name()), err)
+	}
+
+	r.entry = spillEntry{Address: record[0], Credit: credit, Source: record[2], Denom: record[3], Amount: record[4]}
+
+	return true, nil
+}
+
+// spillRuns is a min-heap of runs ordered by their current entry.
+type spillRuns []*spillRun
+
+func (h spillRuns) Len() int      { return len(h) }
+func (h spillRuns) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *spillRuns) Push(x any)   { *h = append(*h, x.(*spillRun)) }
+func (h spillRuns) Less(i, j int) bool {
+	a, b := h[i].entry, h[j].entry
+	if a.Address != b.Address || a.Credit != b.Credit {
+		return a.Less(b)
+	}
+	return h[i].index < h[j].index
+}
+func (h *spillRuns) Pop() any {
+	old := *h
+	run := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return run
+}
@@ -0,0 +1,68 @@
+package migrate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpillSorterMerge(t *testing.T) {
+	t.Parallel()
+
+	entries := []spillEntry{
+		{Address: "c", Credit: 1, Source: "balances.csv", Denom: "ubear", Amount: "1"},
+		{Address: "a", Credit: 2, Source: "balances.csv", Denom: "ubear", Amount: "2"},
+		{Address: "b", Credit: 3, Source: "kaway_bond.csv", Denom: "ugadikian", Amount: "3"},
+		{Address: "a", Credit: 4, Source: "kaway_bond.csv", Denom: "ugadikian", Amount: "4"},
+		{Address: "c", Credit: 5, Source: "uwuval_bond.csv", Denom: "ugadikian", Amount: "5"},
+		{Address: "a", Credit: 6, Source: "uwuval_bond.csv", Denom: "ugadikian", Amount: "6"},
+		{Address: "a", Credit: 6, Source: "uwuval_bond.csv", Denom: "ubear", Amount: "7"},
+	}
+	expected := []string{
+		"a 2:2ubear 4:4ugadikian 6:6ugadikian 6:7ubear",
+		"b 3:3ugadikian",
+		"c 1:1ubear 5:5ugadikian",
+	}
+
+	tests := []struct {
+		name  string
+		limit int
+		runs  int
+	}{
+		{name: "single run", limit: 100, runs: 1},
+		{name: "several runs", limit: 2, runs: 4},
+		{name: "run per entry", limit: 1, runs: 7},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sorter := newSpillSorter(t.TempDir(), tc.limit)
+			for _, entry := range entries {
+				require.NoError(t, sorter.add(entry))
+			}
+
+			merge := func() []string {
+				var merged []string
+				err := sorter.merge(func(address string, entries []spillEntry) error {
+					line := address
+					for _, entry := range entries {
+						line += fmt.Sprintf(" %d:%s%s", entry.Credit, entry.Amount, entry.Denom)
+					}
+					merged = append(merged, line)
+					return nil
+				})
+				require.NoError(t, err)
+				return merged
+			}
+
+			require.Equal(t, expected, merge())
+			require.Len(t, sorter.runs, tc.runs)
+
+			// Merging again gives the same entries
+			require.Equal(t, expected, merge())
+		})
+	}
+}
@@ -0,0 +1,633 @@
+package migrate
+
+import (
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	simapp "github.com/unicorn-research/chain"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Staking modes.
+const (
+	// StakingModeLiquid credits bonded amounts to their owners as liquid coins.
+	StakingModeLiquid = "liquid"
+	// StakingModeDelegations recreates validators and delegations in the staking genesis.
+	StakingModeDelegations = "delegations"
+)
+
+// BondDenom is the staking denom of the unicorn chain.
+const BondDenom = "uwunicorn"
+
+// FallbackDelegator is the delegator entry in the validator map that applies to
+// every delegator without an entry of its own.
+const FallbackDelegator = "*"
+
+// Validator is a validator recreated from uwuval_bond.csv.
+type Validator struct {
+	Address  string // Operator account address
+	SelfBond math.Int
+	PubKey   cryptotypes.PubKey
+	Tokens   math.Int // Self-bond plus delegations, set by fundStakingPools
+	Bonded   bool
+}
+
+// Delegation is a bond from kaway_bond.csv.
+type Delegation struct {
+	Delegator string
+	Validator string // Operator account address of the validator, empty until resolved
+	Amount    math.Int
+}
+
+// Process uwuval_bond.csv into validators and kaway_bond.csv into delegations.
+func processStakingFiles(data *GenesisData) error {
+	input := data.Manifest.Input
+
+	data.logf("Processing %s...\n", input.UwuvalBond)
+	validators, err := readBonds(data, input.path(input.UwuvalBond))
+	if err != nil {
+		return fmt.Errorf("error processing uwuval_bond: %w", err)
+	}
+
+	for _, bond := range validators {
+		ensureAccount(data, bond.Delegator)
+		data.Validators[bond.Delegator] = &Validator{
+			Address:  bond.Delegator,
+			SelfBond: bond.Amount,
+		}
+	}
+
+	data.logf("Processing %s...\n", input.KawayBond)
+	delegations, err := readBonds(data, input.path(input.KawayBond))
+	if err != nil {
+		return fmt.Errorf("error processing kaway_bond: %w", err)
+	}
+
+	for _, bond := range delegations {
+		ensureAccount(data, bond.Delegator)
+	}
+	data.Delegations = delegations
+
+	return nil
+}
+
+// Read the non-zero bonds from a bond CSV file.
+//
+// The file has the columns address and amount, and optionally a column named
+// validator holding the operator account address the bond is delegated to.
+// Bad rows are reported to data.RowErrors.
+func readBonds(data *GenesisData, filePath string) ([]*Delegation, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", filepath.Base(filePath), err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	if len(header) < 2 || header[0] != "address" {
+		return nil, fmt.Errorf("unexpected header format in %s, expected: address,amount", filepath.Base(filePath))
+	}
+
+	validatorColumn := -1
+	for i, name := range header {
+		if name == "validator" {
+			validatorColumn = i
+		}
+	}
+
+	var bonds []*Delegation
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading row: %w", err)
+		}
+
+		bond, rowErr := parseBondRow(data.Manifest, filepath.Base(filePath), recordLine(reader), header, row, validatorColumn)
+		if rowErr != nil {
+			if err := data.RowErrors.report(rowErr); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		// Skip empty amounts
+		if bond.Amount.IsZero() {
+			continue
+		}
+
+		bonds = append(bonds, bond)
+	}
+
+	return bonds, nil
+}
+
+// Parse a single bond row.
+func parseBondRow(m *Manifest, fileName string, line int, header, row []string, validatorColumn int) (*Delegation, *RowError) {
+	amount, rowErr := parseAmountField(fileName, line, header[1], row[1])
+	if rowErr != nil {
+		return nil, rowErr
+	}
+
+	if rowErr := m.checkAddress(fileName, line, header[0], row[0]); rowErr != nil {
+		return nil, rowErr
+	}
+
+	bond := &Delegation{Delegator: row[0], Amount: amount}
+	if validatorColumn >= 0 && row[validatorColumn] != "" {
+		if rowErr := m.checkAddress(fileName, line, header[validatorColumn], row[validatorColumn]); rowErr != nil {
+			return nil, rowErr
+		}
+		bond.Validator = row[validatorColumn]
+	}
+
+	return bond, nil
+}
+
+// Attach consensus keys to validators and assign every delegation to a validator.
+//
+// Validators without a consensus key in keysPath cannot be recreated, so their
+// self-bond is credited as liquid coins. Delegations whose validator is missing
+// are assigned through the validator map in mapPath: first by the delegator's
+// own entry, then by the "*" entry. Delegations that still have no validator
+// are credited to the delegator as liquid coins. Unbonding entries are
+// resolved the same way.
+func resolveStaking(data *GenesisData, keysPath, mapPath string) error {
+	keys, err := readValidatorKeys(data.Manifest, keysPath)
+	if err != nil {
+		return err
+	}
+
+	validatorMap, err := readValidatorMap(data.Manifest, mapPath)
+	if err != nil {
+		return err
+	}
+
+	for address, validator := range data.Validators {
+		pubKey, ok := keys[address]
+		if !ok {
+			data.logf("Warning: validator %s has no consensus key, crediting its self-bond as liquid\n", address)
+			delete(data.Validators, address)
+			if err := creditBond(data, address, data.Manifest.Input.source(data.Manifest.Input.UwuvalBond), validator.SelfBond); err != nil {
+				return err
+			}
+			continue
+		}
+		validator.PubKey = pubKey
+	}
+
+	resolved := make([]*Delegation, 0, len(data.Delegations))
+	liquid := 0
+	for _, delegation := range data.Delegations {
+		if validator, ok := lookupValidator(data, validatorMap, delegation); ok {
+			delegation.Validator = validator
+			resolved = append(resolved, delegation)
+			continue
+		}
+
+		if err := creditBond(data, delegation.Delegator, data.Manifest.Input.source(data.Manifest.Input.KawayBond), delegation.Amount); err != nil {
+			return err
+		}
+		liquid++
+	}
+	data.Delegations = resolved
+
+	data.logf("Resolved %d validators and %d delegations, %d delegations credited as liquid\n",
+		len(data.Validators), len(resolved), liquid)
+
+	return resolveUnbondings(data, validatorMap)
+}
+
+// Find the validator a delegation belongs to, falling back to the validator map.
+func lookupValidator(data *GenesisData, validatorMap map[string]string, delegation *Delegation) (string, bool) {
+	candidates := []string{
+		delegation.Validator,
+		validatorMap[delegation.Delegator],
+		validatorMap[FallbackDelegator],
+	}
+
+	for _, candidate := range candidates {
+		if _, ok := data.Validators[candidate]; ok {
+			return candidate, true
+		}
+	}
+
+	return "", false
+}
+
+// Credit a bond that cannot be recreated in staking to its owner as liquid coins.
+func creditBond(data *GenesisData, address, source string, amount math.Int) error {
+	return data.Balances.addAmount(address, source, BondDenom, amount)
+}
+
+// Read the consensus keys of the validators, keyed by operator account address.
+//
+// The file has the columns address and pubkey, where pubkey is a base64
+// encoded ed25519 public key as found in priv_validator_key.json.
+func readValidatorKeys(m *Manifest, filePath string) (map[string]cryptotypes.PubKey, error) {
+	rows, err := readAddressPairs(m, filePath, []string{"address", "pubkey"}, false)
+	if err != nil {
+		return nil, fmt.Errorf("error processing validator keys: %w", err)
+	}
+
+	keys := make(map[string]cryptotypes.PubKey, len(rows))
+	for address, encoded := range rows {
+		bz, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid consensus key for %s: %w", address, err)
+		}
+
+		if len(bz) != ed25519.PubKeySize {
+			return nil, fmt.Errorf("invalid consensus key for %s: expected %d bytes, got %d", address, ed25519.PubKeySize, len(bz))
+		}
+
+		keys[address] = &ed25519.PubKey{Key: bz}
+	}
+
+	return keys, nil
+}
+
+// Read the fallback validator map, keyed by delegator address.
+func readValidatorMap(m *Manifest, filePath string) (map[string]string, error) {
+	validatorMap, err := readAddressPairs(m, filePath, []string{"delegator", "validator"}, true)
+	if err != nil {
+		return nil, fmt.Errorf("error processing validator map: %w", err)
+	}
+
+	return validatorMap, nil
+}
+
+// Read a two-column CSV file into a map, returning an empty map if no file is given.
+//
+// The first column holds an address, or the fallback delegator; when
+// addressValues is set, so does the second.
+func readAddressPairs(m *Manifest, filePath string, expectedHeader []string, addressValues bool) (map[string]string, error) {
+	pairs := make(map[string]string)
+	if filePath == "" {
+		return pairs, nil
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", filepath.Base(filePath), err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	if !headerMatches(header, expectedHeader) {
+		return nil, fmt.Errorf("unexpected header format in %s, expected: %v", filepath.Base(filePath), expectedHeader)
+	}
+
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading row: %w", err)
+		}
+
+		columns := row[:1]
+		if addressValues {
+			columns = row[:2]
+		}
+		// Configuration files are not skipped row by row, so any bad address is fatal
+		for i, address := range columns {
+			if address == FallbackDelegator {
+				continue
+			}
+			if rowErr := m.checkAddress(filepath.Base(filePath), recordLine(reader), header[i], address); rowErr != nil {
+				return nil, rowErr
+			}
+		}
+
+		if _, exists := pairs[row[0]]; exists {
+			return nil, fmt.Errorf("duplicate entry for %s in %s", row[0], filepath.Base(filePath))
+		}
+		pairs[row[0]] = row[1]
+	}
+
+	return pairs, nil
+}
+
+// Convert validator, delegation and unbonding addresses to the target chain.
+func convertStakingPrefixes(data *GenesisData) error {
+	convertedValidators := make(map[string]*Validator, len(data.Validators))
+	for oldAddress, validator := range data.Validators {
+		newAddress, err := data.Manifest.convertAddress(oldAddress)
+		if err != nil {
+			return err
+		}
+		validator.Address = newAddress
+		convertedValidators[newAddress] = validator
+	}
+	data.Validators = convertedValidators
+
+	for _, delegation := range data.Delegations {
+		delegator, err := data.Manifest.convertAddress(delegation.Delegator)
+		if err != nil {
+			return err
+		}
+
+		validator, err := data.Manifest.convertAddress(delegation.Validator)
+		if err != nil {
+			return err
+		}
+
+		delegation.Delegator = delegator
+		delegation.Validator = validator
+	}
+
+	for _, unbonding := range data.Unbondings {
+		delegator, err := data.Manifest.convertAddress(unbonding.Delegator)
+		if err != nil {
+			return err
+		}
+
+		validator, err := data.Manifest.convertAddress(unbonding.Validator)
+		if err != nil {
+			return err
+		}
+
+		unbonding.Delegator = delegator
+		unbonding.Validator = validator
+	}
+
+	return nil
+}
+
+// Decide which validators are bonded and fund the staking pools to match.
+//
+// The top maxValidators validators by tokens with non-zero consensus power are
+// bonded and their tokens are held by the bonded pool; all other validators
+// are unbonded and their tokens are held by the not-bonded pool, together with
+// the balances of all unbonding delegations. This is what the staking module
+// checks the pool balances against in InitGenesis.
+func fundStakingPools(data *GenesisData, maxValidators uint32) error {
+	for _, validator := range data.Validators {
+		validator.Tokens = validator.SelfBond
+	}
+	for _, delegation := range data.Delegations {
+		validator := data.Validators[delegation.Validator]
+		validator.Tokens = validator.Tokens.Add(delegation.Amount)
+	}
+
+	bondedTokens := math.ZeroInt()
+	notBondedTokens := math.ZeroInt()
+	// Unbonding entries are held by the not-bonded pool until they complete
+	notBondedTokens = notBondedTokens.Add(totalUnbonding(data))
+
+	for i, validator := range sortedValidators(data) {
+		power := sdk.TokensToConsensusPower(validator.Tokens, sdk.DefaultPowerReduction)
+		validator.Bonded = i < int(maxValidators) && power > 0
+
+		if validator.Bonded {
+			bondedTokens = bondedTokens.Add(validator.Tokens)
+		} else {
+			notBondedTokens = notBondedTokens.Add(validator.Tokens)
+		}
+	}
+
+	if err := creditModuleAccount(data, stakingtypes.BondedPoolName, bondedTokens); err != nil {
+		return err
+	}
+
+	if err := creditModuleAccount(data, stakingtypes.NotBondedPoolName, notBondedTokens); err != nil {
+		return err
+	}
+
+	data.logf("Funded bonded pool with %s and not-bonded pool with %s %s\n",
+		bondedTokens, notBondedTokens, BondDenom)
+
+	return nil
+}
+
+// Return the max_validators staking param of the genesis the migration is
+// built into: that of the genesis it is merged into, or the default, with the
+// manifest's module params applied.
+func stakingMaxValidators(data *GenesisData) (uint32, error) {
+	genesisState := simapp.GenesisState{stakingtypes.ModuleName: json.RawMessage(`{}`)}
+	if path := data.Options.MergeGenesis; path != "" {
+		var err error
+		_, genesisState, err = loadMergeGenesis(path, data.Manifest.ChainID, genesisState)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if overrides, ok := data.Manifest.ModuleParams[stakingtypes.ModuleName]; ok {
+		moduleParams := map[string]map[string]any{stakingtypes.ModuleName: overrides}
+		if err := applyModuleParams(genesisState, moduleParams); err != nil {
+			return 0, err
+		}
+	}
+
+	var state struct {
+		Params struct {
+			MaxValidators json.RawMessage `json:"max_validators"`
+		} `json:"params"`
+	}
+	if err := json.Unmarshal(genesisState[stakingtypes.ModuleName], &state); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal staking genesis: %w", err)
+	}
+	if state.Params.MaxValidators == nil {
+		return stakingtypes.DefaultParams().MaxValidators, nil
+	}
+
+	// The proto JSON of a uint32 may be quoted
+	value := strings.Trim(string(state.Params.MaxValidators), `"`)
+	maxValidators, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid staking max_validators %s: %w", state.Params.MaxValidators, err)
+	}
+
+	return uint32(maxValidators), nil
+}
+
+// Credit the bond denom to a module account.
+//
+// Module accounts are deliberately not added to data.Accounts: the staking
+// module creates its pool accounts itself during InitGenesis.
+func creditModuleAccount(data *GenesisData, moduleName string, amount math.Int) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	address, err := sdk.Bech32ifyAddressBytes(data.Manifest.Prefixes.Target, authtypes.NewModuleAddress(moduleName))
+	if err != nil {
+		return err
+	}
+
+	denom, err := data.Manifest.convertDenom(BondDenom)
+	if err != nil {
+		return err
+	}
+
+	return data.Balances.addAmount(address, moduleName, denom, amount)
+}
+
+// Return the validators by descending tokens, then by address.
+func sortedValidators(data *GenesisData) []*Validator {
+	validators := make([]*Validator, 0, len(data.Validators))
+	for _, validator := range data.Validators {
+		validators = append(validators, validator)
+	}
+
+	sort.Slice(validators, func(i, j int) bool {
+		if !validators[i].Tokens.Equal(validators[j].Tokens) {
+			return validators[i].Tokens.GT(validators[j].Tokens)
+		}
+		return validators[i].Address < validators[j].Address
+	})
+
+	return validators
+}
+
+// Fill the staking genesis with the recreated validators, delegations and unbonding delegations.
+func setStakingGenesis(cdc codec.JSONCodec, genesisState simapp.GenesisState, data *GenesisData) error {
+	var stakingGenState stakingtypes.GenesisState
+	if err := cdc.UnmarshalJSON(genesisState[stakingtypes.ModuleName], &stakingGenState); err != nil {
+		return fmt.Errorf("failed to unmarshal staking genesis: %w", err)
+	}
+
+	bondDenom, err := data.Manifest.convertDenom(BondDenom)
+	if err != nil {
+		return err
+	}
+	stakingGenState.Params.BondDenom = bondDenom
+
+	for _, val := range sortedValidators(data) {
+		validator, err := newGenesisValidator(data.Manifest, val)
+		if err != nil {
+			return err
+		}
+		stakingGenState.Validators = append(stakingGenState.Validators, validator)
+	}
+
+	delegations, err := genesisDelegations(data)
+	if err != nil {
+		return err
+	}
+	stakingGenState.Delegations = append(stakingGenState.Delegations, delegations...)
+
+	unbondingDelegations, err := genesisUnbondingDelegations(data)
+	if err != nil {
+		return err
+	}
+	stakingGenState.UnbondingDelegations = append(stakingGenState.UnbondingDelegations, unbondingDelegations...)
+
+	stakingGenStateBz, err := cdc.MarshalJSON(&stakingGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal staking genesis: %w", err)
+	}
+	genesisState[stakingtypes.ModuleName] = stakingGenStateBz
+
+	return nil
+}
+
+// Build the staking validator for a recreated validator.
+func newGenesisValidator(m *Manifest, val *Validator) (stakingtypes.Validator, error) {
+	operator, err := m.operatorAddress(val.Address)
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	validator, err := stakingtypes.NewValidator(operator, val.PubKey, stakingtypes.Description{})
+	if err != nil {
+		return stakingtypes.Validator{}, fmt.Errorf("failed to create validator %s: %w", operator, err)
+	}
+
+	validator.Tokens = val.Tokens
+	validator.DelegatorShares = math.LegacyNewDecFromInt(val.Tokens)
+	if val.Bonded {
+		validator.Status = stakingtypes.Bonded
+	}
+
+	return validator, nil
+}
+
+// Build one delegation per delegator and validator pair, including self-delegations.
+//
+// Shares are issued one to one with tokens, since no validator has been slashed yet.
+func genesisDelegations(data *GenesisData) ([]stakingtypes.Delegation, error) {
+	type pair struct{ delegator, validator string }
+
+	amounts := make(map[pair]math.Int)
+	add := func(key pair, amount math.Int) {
+		if existing, ok := amounts[key]; ok {
+			amount = existing.Add(amount)
+		}
+		amounts[key] = amount
+	}
+
+	for address, validator := range data.Validators {
+		add(pair{address, address}, validator.SelfBond)
+	}
+	for _, delegation := range data.Delegations {
+		add(pair{delegation.Delegator, delegation.Validator}, delegation.Amount)
+	}
+
+	keys := make([]pair, 0, len(amounts))
+	for key := range amounts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].delegator != keys[j].delegator {
+			return keys[i].delegator < keys[j].delegator
+		}
+		return keys[i].validator < keys[j].validator
+	})
+
+	delegations := make([]stakingtypes.Delegation, 0, len(keys))
+	for _, key := range keys {
+		operator, err := data.Manifest.operatorAddress(key.validator)
+		if err != nil {
+			return nil, err
+		}
+
+		shares := math.LegacyNewDecFromInt(amounts[key])
+		delegations = append(delegations, stakingtypes.NewDelegation(key.delegator, operator, shares))
+	}
+
+	return delegations, nil
+}
+
+// Convert an operator account address to its validator operator address.
+func (m *Manifest) operatorAddress(address string) (string, error) {
+	bz, err := sdk.GetFromBech32(address, m.Prefixes.Target)
+	if err != nil {
+		return "", fmt.Errorf("invalid validator address %s: %w", address, err)
+	}
+
+	return sdk.Bech32ifyAddressBytes(m.Prefixes.Target+sdk.PrefixValidator+sdk.PrefixOperator, bz)
+}
@@ -0,0 +1,278 @@
+package migrate
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestResolveStaking(t *testing.T) {
+	t.Parallel()
+
+	const (
+		validator = "unicorn1qqyl24rxge02cgkqnq4p2340s28kdd89zld79f"
+		delegator = "unicorn1qqxm5thy3xjwwmz8re26d6kdme9y60jfrzhag3"
+		other     = "unicorn1qq856jck3gcqax4jsu3pdqa5kxjc3smysgyjm3"
+	)
+
+	pubKey := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, ed25519.PubKeySize))
+
+	tests := []struct {
+		name         string
+		keys         string
+		validatorMap string
+		bondedTo     string            // Validator the delegation names in kaway_bond.csv
+		validators   []string          // Validators recreated
+		delegations  map[string]string // Validators of the recreated delegations, keyed by delegator
+		liquid       map[string]string // Bonds credited as liquid coins
+		err          string
+	}{
+		{
+			name:        "delegation to its validator",
+			keys:        "address,pubkey\n" + validator + "," + pubKey + "\n",
+			bondedTo:    validator,
+			validators:  []string{validator},
+			delegations: map[string]string{delegator: validator},
+			liquid:      map[string]string{},
+		},
+		{
+			name:         "delegator entry of the validator map",
+			keys:         "address,pubkey\n" + validator + "," + pubKey + "\n",
+			validatorMap: "delegator,validator\n" + delegator + "," + validator + "\n",
+			bondedTo:     other,
+			validators:   []string{validator},
+			delegations:  map[string]string{delegator: validator},
+			liquid:       map[string]string{},
+		},
+		{
+			name:         "fallback entry of the validator map",
+			keys:         "address,pubkey\n" + validator + "," + pubKey + "\n",
+			validatorMap: "delegator,validator\n*," + validator + "\n",
+			validators:   []string{validator},
+			delegations:  map[string]string{delegator: validator},
+			liquid:       map[string]string{},
+		},
+		{
+			name:        "unknown validator",
+			keys:        "address,pubkey\n" + validator + "," + pubKey + "\n",
+			bondedTo:    other,
+			validators:  []string{validator},
+			delegations: map[string]string{},
+			liquid:      map[string]string{delegator: "40uwunicorn"},
+		},
+		{
+			// Without its consensus key the validator cannot be recreated,
+			// so neither can the delegations to it
+			name:        "validator without consensus key",
+			keys:        "address,pubkey\n",
+			bondedTo:    validator,
+			validators:  []string{},
+			delegations: map[string]string{},
+			liquid:      map[string]string{validator: "100uwunicorn", delegator: "40uwunicorn"},
+		},
+		{
+			name:     "invalid consensus key",
+			keys:     "address,pubkey\n" + validator + "," + base64.StdEncoding.EncodeToString([]byte{1}) + "\n",
+			bondedTo: validator,
+			err:      "expected 32 bytes, got 1",
+		},
+		{
+			name:         "duplicate validator map entry",
+			keys:         "address,pubkey\n" + validator + "," + pubKey + "\n",
+			validatorMap: "delegator,validator\n*," + validator + "\n*," + other + "\n",
+			err:          "duplicate entry for *",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			keysPath := filepath.Join(dir, "validator_keys.csv")
+			require.NoError(t, os.WriteFile(keysPath, []byte(tc.keys), 0o600))
+
+			var mapPath string
+			if tc.validatorMap != "" {
+				mapPath = filepath.Join(dir, "validator_map.csv")
+				require.NoError(t, os.WriteFile(mapPath, []byte(tc.validatorMap), 0o600))
+			}
+
+			data := &GenesisData{
+				Manifest: DefaultManifest(),
+				Balances: newLedger(),
+				Validators: map[string]*Validator{
+					validator: {Address: validator, SelfBond: math.NewInt(100)},
+				},
+				Delegations: []*Delegation{
+					{Delegator: delegator, Validator: tc.bondedTo, Amount: math.NewInt(40)},
+				},
+			}
+
+			err := resolveStaking(data, keysPath, mapPath)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			validators := make([]string, 0, len(data.Validators))
+			for address, val := range data.Validators {
+				require.NotNil(t, val.PubKey)
+				validators = append(validators, address)
+			}
+			require.Equal(t, tc.validators, validators)
+
+			delegations := make(map[string]string, len(data.Delegations))
+			for _, delegation := range data.Delegations {
+				delegations[delegation.Delegator] = delegation.Validator
+			}
+			require.Equal(t, tc.delegations, delegations)
+
+			liquid := make(map[string]string)
+			for _, address := range data.Balances.addresses() {
+				liquid[address] = data.Balances.balance(address).String()
+			}
+			require.Equal(t, tc.liquid, liquid)
+		})
+	}
+}
+
+func TestFundStakingPools(t *testing.T) {
+	t.Parallel()
+
+	const (
+		large = "gadikian1qqyl24rxge02cgkqnq4p2340s28kdd899g2kmn"
+		small = "gadikian1rn9f6ack3u8t3ed04pfaqpmh5zfp2m2lczu747"
+	)
+
+	manifest := DefaultManifest()
+	bondedPool, err := manifest.moduleAddress(stakingtypes.BondedPoolName)
+	require.NoError(t, err)
+	notBondedPool, err := manifest.moduleAddress(stakingtypes.NotBondedPoolName)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		maxValidators uint32
+		smallBond     int64 // Self-bond of the small validator
+		unbonding     int64
+		bonded        map[string]bool
+		expected      map[string]string // Pool balances
+	}{
+		{
+			name:          "all bonded",
+			maxValidators: 2,
+			smallBond:     2_000_000,
+			bonded:        map[string]bool{large: true, small: true},
+			expected:      map[string]string{bondedPool: "6000000ugadikian"},
+		},
+		{
+			name:          "max validators",
+			maxValidators: 1,
+			smallBond:     2_000_000,
+			bonded:        map[string]bool{large: true, small: false},
+			expected:      map[string]string{bondedPool: "4000000ugadikian", notBondedPool: "2000000ugadikian"},
+		},
+		{
+			// Less than one consensus power cannot be bonded
+			name:          "zero consensus power",
+			maxValidators: 2,
+			smallBond:     999_999,
+			bonded:        map[string]bool{large: true, small: false},
+			expected:      map[string]string{bondedPool: "4000000ugadikian", notBondedPool: "999999ugadikian"},
+		},
+		{
+			name:          "unbonding entries",
+			maxValidators: 2,
+			smallBond:     2_000_000,
+			unbonding:     7,
+			bonded:        map[string]bool{large: true, small: true},
+			expected:      map[string]string{bondedPool: "6000000ugadikian", notBondedPool: "7ugadikian"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := &GenesisData{
+				Manifest: manifest,
+				Balances: newLedger(),
+				Validators: map[string]*Validator{
+					large: {Address: large, SelfBond: math.NewInt(3_000_000)},
+					small: {Address: small, SelfBond: math.NewInt(tc.smallBond)},
+				},
+				Delegations: []*Delegation{
+					{Delegator: small, Validator: large, Amount: math.NewInt(1_000_000)},
+				},
+			}
+			if tc.unbonding > 0 {
+				data.Unbondings = []*Unbonding{{Delegator: small, Validator: large, Amount: math.NewInt(tc.unbonding)}}
+			}
+
+			require.NoError(t, fundStakingPools(data, tc.maxValidators))
+
+			bonded := make(map[string]bool, len(data.Validators))
+			for address, val := range data.Validators {
+				bonded[address] = val.Bonded
+			}
+			require.Equal(t, tc.bonded, bonded)
+			require.Equal(t, "4000000", data.Validators[large].Tokens.String())
+
+			balances := make(map[string]string)
+			for _, address := range data.Balances.addresses() {
+				balances[address] = data.Balances.balance(address).String()
+			}
+			require.Equal(t, tc.expected, balances)
+		})
+	}
+}
+
+func TestStakingMaxValidators(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		merged    string // Staking genesis of the genesis to merge into, none when empty
+		overrides map[string]any
+		expected  uint32
+	}{
+		{name: "default", expected: stakingtypes.DefaultParams().MaxValidators},
+		{name: "merged genesis", merged: `{"params":{"max_validators":7}}`, expected: 7},
+		{name: "merged genesis without staking params", merged: `{}`, expected: stakingtypes.DefaultParams().MaxValidators},
+		{name: "module params", overrides: map[string]any{"max_validators": 5}, expected: 5},
+		{name: "module params over merged genesis", merged: `{"params":{"max_validators":7}}`, overrides: map[string]any{"max_validators": 5}, expected: 5},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := &GenesisData{Manifest: DefaultManifest()}
+			if tc.merged != "" {
+				data.Options.MergeGenesis = filepath.Join(t.TempDir(), "genesis.json")
+				appState := []byte(`{"staking":` + tc.merged + `}`)
+				appGenesis := genutiltypes.NewAppGenesisWithVersion(data.Manifest.ChainID, appState)
+				require.NoError(t, appGenesis.SaveAs(data.Options.MergeGenesis))
+			}
+			if tc.overrides != nil {
+				data.Manifest.ModuleParams[stakingtypes.ModuleName] = tc.overrides
+			}
+
+			maxValidators, err := stakingMaxValidators(data)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, maxValidators)
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package migrate
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// StreamGenesis writes the genesis of a streamed migration to w.
+//
+// The migrated accounts and balances are read back from disk in address order
+// and encoded one at a time, so they are never all held in memory. Account
+// numbers follow address order. Every other module is built and validated as
+// Build does; accounts and balances are validated one by one as they are
+// written, and the supply against their total.
+func StreamGenesis(data *GenesisData, w io.Writer) error {
+	if !data.Balances.spilled() {
+		return errors.New("only a streamed migration can be streamed, build it with Build instead")
+	}
+
+	modules, cleanup, err := newGenesisApp(data.Manifest.Prefixes.Target)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	data.logf("Streaming genesis.json...\n")
+	if err := streamGenesis(data, modules, w); err != nil {
+		return fmt.Errorf("error generating genesis JSON: %w", err)
+	}
+
+	return nil
+}
+
+// Write the genesis of a streamed migration with the given app modules.
+func streamGenesis(data *GenesisData, app Modules, w io.Writer) error {
+	manifest := data.Manifest
+	cdc := app.Codec
+
+	if prefix := sdk.GetConfig().GetBech32AccountAddrPrefix(); prefix != manifest.Prefixes.Target {
+		return fmt.Errorf("the app uses address prefix %s, but the migration is to %s", prefix, manifest.Prefixes.Target)
+	}
+
+	genesisState := app.BasicManager.DefaultGenesis(cdc)
+	if err := applyModuleParams(genesisState, manifest.ModuleParams); err != nil {
+		return err
+	}
+
+	supply, err := toSDKCoins(data.Supply)
+	if err != nil {
+		return err
+	}
+	if total := data.Balances.total(); !supply.Equal(total) {
+		return fmt.Errorf("supply %s does not match the migrated balances %s", supply, total)
+	}
+
+	var bankGenesis banktypes.GenesisState
+	if err := cdc.UnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal bank genesis: %w", err)
+	}
+	bankGenesis.DenomMetadata = append(bankGenesis.DenomMetadata, data.DenomMetadata...)
+
+	// Validate every module before anything is written. Bank checks its supply
+	// against its balances, which are only streamed, so it is validated without
+	// a supply; the supply was checked against the balances' total above.
+	bankState, err := cdc.MarshalJSON(&bankGenesis)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bank genesis: %w", err)
+	}
+	genesisState[banktypes.ModuleName] = bankState
+	if err := app.BasicManager.ValidateGenesis(cdc, app.TxConfig, genesisState); err != nil {
+		return fmt.Errorf("genesis failed validation: %w", err)
+	}
+
+	bankGenesis.Supply = supply
+	bankState, err = cdc.MarshalJSON(&bankGenesis)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bank genesis: %w", err)
+	}
+	genesisState[banktypes.ModuleName] = bankState
+
+	consensusParams, err := manifest.consensusParams()
+	if err != nil {
+		return err
+	}
+
+	// The app state is streamed in place of this placeholder
+	appGenesis := genutiltypes.NewAppGenesisWithVersion(manifest.ChainID, json.RawMessage("{}"))
+	appGenesis.GenesisTime = data.GenesisTime
+	appGenesis.Consensus.Params = consensusParams
+	if err := appGenesis.ValidateAndComplete(); err != nil {
+		return fmt.Errorf("invalid genesis: %w", err)
+	}
+
+	header, err := json.Marshal(appGenesis)
+	if err != nil {
+		return fmt.Errorf("failed to marshal genesis: %w", err)
+	}
+
+	writer := &jsonWriter{w: bufio.NewWriter(w)}
+	err = writer.object(0, header, map[string]func(int) error{
+		"app_state": func(depth int) error {
+			return writeAppState(writer, depth, data, cdc, genesisState)
+		},
+	})
+	if err != nil {
+		return err
+	}
+	writer.w.WriteString("\n")
+
+	return writer.w.Flush()
+}
+
+// Write the app state, streaming the auth accounts and the bank balances.
+func writeAppState(writer *jsonWriter, depth int, data *GenesisData, cdc codec.JSONCodec, genesisState map[string]json.RawMessage) error {
+	modules := make([]string, 0, len(genesisState))
+	for module := range genesisState {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	writer.w.WriteString("{")
+	for i, module := range modules {
+		if i > 0 {
+			writer.w.WriteString(",")
+		}
+		writer.field(depth+1, module)
+
+		var err error
+		switch module {
+		case authtypes.ModuleName:
+			err = writer.object(depth+1, genesisState[module], map[string]func(int) error{
+				"accounts": func(depth int) error { return writeAccounts(writer, depth, data, cdc) },
+			})
+		case banktypes.ModuleName:
+			err = writer.object(depth+1, genesisState[module], map[string]func(int) error{
+				"balances": func(depth int) error { return writeBalances(writer, depth, data, cdc) },
+			})
+		default:
+			err = writer.value(depth+1, genesisState[module])
+		}
+		if err != nil {
+			return err
+		}
+	}
+	writer.newline(depth)
+	writer.w.WriteString("}")
+
+	return nil
+}
+
+// Write an account for every migrated address, numbered in address order.
+func writeAccounts(writer *jsonWriter, depth int, data *GenesisData, cdc codec.JSONCodec) error {
+	data.AccountCounter = 0
+
+	return writer.array(depth, func(element func([]byte) error) error {
+		return data.Balances.each(func(address string, _ sdk.Coins, _ []string) error {
+			accAddress, err := sdk.AccAddressFromBech32(address)
+			if err != nil {
+				return fmt.Errorf("invalid account address %s: %w", address, err)
+			}
+
+			account := authtypes.NewBaseAccount(accAddress, nil, data.AccountCounter, 0)
+			if err := account.Validate(); err != nil {
+				return fmt.Errorf("invalid account %s: %w", address, err)
+			}
+
+			bz, err := cdc.MarshalInterfaceJSON(account)
+			if err != nil {
+				return fmt.Errorf("failed to marshal account %s: %w", address, err)
+			}
+			data.AccountCounter++
+
+			return element(bz)
+		})
+	})
+}
+
+// Write the balance of every migrated address, in address order.
+func writeBalances(writer *jsonWriter, depth int, data *GenesisData, cdc codec.JSONCodec) error {
+	return writer.array(depth, func(element func([]byte) error) error {
+		return data.Balances.each(func(address string, coins sdk.Coins, _ []string) error {
+			balance := banktypes.Balance{Address: address, Coins: coins}
+			if err := balance.Validate(); err != nil {
+				return fmt.Errorf("invalid balance for %s: %w", address, err)
+			}
+
+			bz, err := cdc.MarshalJSON(&balance)
+			if err != nil {
+				return fmt.Errorf("failed to marshal balance of %s: %w", address, err)
+			}
+
+			return element(bz)
+		})
+	})
+}
+
+// jsonWriter writes indented JSON piece by piece, so the members of an array
+// can be encoded one at a time rather than all at once.
+//
+// Write errors are sticky in the bufio.Writer and returned by its Flush.
+type jsonWriter struct {
+	w *bufio.Writer
+}
+
+// Start a new line indented to depth.
+func (j *jsonWriter) newline(depth int) {
+	j.w.WriteString("\n")
+	j.w.WriteString(strings.Repeat("  ", depth))
+}
+
+// Write the key of an object field at depth.
+func (j *jsonWriter) field(depth int, key string) {
+	bz, _ := json.Marshal(key)
+	j.newline(depth)
+	j.w.Write(bz)
+	j.w.WriteString(": ")
+}
+
+// Write a complete JSON value nested at depth.
+func (j *jsonWriter) value(depth int, raw []byte) error {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, raw, strings.Repeat("  ", depth), "  "); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+	_, err := buf.WriteTo(j.w)
+
+	return err
+}
+
+// Write the JSON object raw nested at depth, keeping the order of its fields
+// but writing the value of any field in overrides with its function instead.
+func (j *jsonWriter) object(depth int, raw []byte, overrides map[string]func(depth int) error) error {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return errors.New("invalid JSON: expected an object")
+	}
+
+	j.w.WriteString("{")
+	fields := 0
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return fmt.Errorf("invalid JSON: %w", err)
+		}
+		key, _ := token.(string)
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return fmt.Errorf("invalid JSON: %w", err)
+		}
+
+		if fields > 0 {
+			j.w.WriteString(",")
+		}
+		j.field(depth+1, key)
+		fields++
+
+		if write, ok := overrides[key]; ok {
+			err = write(depth + 1)
+		} else {
+			err = j.value(depth+1, value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if fields > 0 {
+		j.newline(depth)
+	}
+	j.w.WriteString("}")
+
+	return nil
+}
+
+// Write a JSON array nested at depth, whose elements each writes by calling
+// element once per element.
+func (j *jsonWriter) array(depth int, each func(element func(raw []byte) error) error) error {
+	j.w.WriteString("[")
+	elements := 0
+	err := each(func(raw []byte) error {
+		if elements > 0 {
+			j.w.WriteString(",")
+		}
+		j.newline(depth + 1)
+		elements++
+
+		return j.value(depth+1, raw)
+	})
+	if err != nil {
+		return err
+	}
+
+	if elements > 0 {
+		j.newline(depth)
+	}
+	j.w.WriteString("]")
+
+	return nil
+}
@@ -0,0 +1,279 @@
+package migrate
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Unbonding policies.
+const (
+	// UnbondingPolicyLiquid credits unbonding amounts to their owners as liquid coins.
+	UnbondingPolicyLiquid = "liquid"
+	// UnbondingPolicyVesting credits unbonding amounts as coins that vest at the completion time.
+	UnbondingPolicyVesting = "vesting"
+	// UnbondingPolicyUnbonding recreates unbonding delegations in the staking genesis.
+	UnbondingPolicyUnbonding = "unbonding"
+)
+
+// Unbonding is an in-flight unbonding entry from kaway_unbond.csv or uwuval_unbond.csv.
+type Unbonding struct {
+	Source         string // Name of the file the entry was read from
+	Delegator      string
+	Validator      string // Operator account address of the validator, empty until resolved
+	Amount         math.Int
+	CompletionTime time.Time
+}
+
+// Process kaway_unbond.csv and uwuval_unbond.csv according to data.UnbondingPolicy.
+//
+// Each file has the columns address and amount, and optionally validator (the
+// operator account address the tokens are unbonding from; uwuval_unbond.csv
+// entries default to the address itself) and remaining (the time left until
+// completion, as a Go duration such as 72h). Entries without a remaining
+// column complete a full unbonding period after genesis.
+func processUnbondings(data *GenesisData) error {
+	input := data.Manifest.Input
+	files := []struct {
+		name          string
+		selfUnbonding bool
+	}{
+		{input.KawayUnbond, false},
+		{input.UwuvalUnbond, true},
+	}
+
+	for _, file := range files {
+		data.logf("Processing %s...\n", file.name)
+		if !input.exists(file.name) {
+			data.logf("%s not found, skipping...\n", file.name)
+			continue
+		}
+
+		unbondings, err := readUnbondings(data, input.path(file.name))
+		if err != nil {
+			return fmt.Errorf("error processing %s: %w", file.name, err)
+		}
+
+		for _, unbonding := range unbondings {
+			if file.selfUnbonding && unbonding.Validator == "" {
+				unbonding.Validator = unbonding.Delegator
+			}
+			unbonding.Source = input.source(file.name)
+
+			ensureAccount(data, unbonding.Delegator)
+			if err := applyUnbonding(data, unbonding); err != nil {
+				return fmt.Errorf("error processing %s: %w", file.name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Credit a single unbonding entry according to the policy.
+func applyUnbonding(data *GenesisData, unbonding *Unbonding) error {
+	switch data.UnbondingPolicy {
+	case UnbondingPolicyUnbonding:
+		data.Unbondings = append(data.Unbondings, unbonding)
+		return nil
+	case UnbondingPolicyVesting:
+		coin := sdk.Coin{Denom: BondDenom, Amount: unbonding.Amount}
+		addDelayedVesting(data, unbonding.Delegator, unbonding.Source, coin, unbonding.CompletionTime)
+	}
+
+	return data.Balances.addAmount(unbonding.Delegator, unbonding.Source, BondDenom, unbonding.Amount)
+}
+
+// Read the non-zero unbonding entries from an unbond CSV file.
+//
+// Bad rows are reported to data.RowErrors.
+func readUnbondings(data *GenesisData, filePath string) ([]*Unbonding, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", filepath.Base(filePath), err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	if len(header) < 2 || header[0] != "address" {
+		return nil, fmt.Errorf("unexpected header format in %s, expected: address,amount", filepath.Base(filePath))
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[name] = i
+	}
+
+	var unbondings []*Unbonding
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading row: %w", err)
+		}
+
+		unbonding, rowErr := parseUnbondingRow(data, filepath.Base(filePath), recordLine(reader), header, row, columns)
+		if rowErr != nil {
+			if err := data.RowErrors.report(rowErr); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if unbonding == nil {
+			continue
+		}
+
+		unbondings = append(unbondings, unbonding)
+	}
+
+	return unbondings, nil
+}
+
+// Parse a single unbonding row, returning nil for rows with an empty amount.
+func parseUnbondingRow(data *GenesisData, fileName string, line int, header, row []string, columns map[string]int) (*Unbonding, *RowError) {
+	field := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(row) {
+			return row[i]
+		}
+		return ""
+	}
+
+	amount, rowErr := parseAmountField(fileName, line, header[1], row[1])
+	if rowErr != nil {
+		return nil, rowErr
+	}
+
+	// Skip empty amounts
+	if amount.IsZero() {
+		return nil, nil
+	}
+
+	remaining := stakingtypes.DefaultUnbondingTime
+	if value := field("remaining"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed < 0 {
+			return nil, &RowError{File: fileName, Row: line, Column: "remaining", Value: value, Reason: "remaining must be a non-negative Go duration"}
+		}
+		remaining = parsed
+	}
+
+	if rowErr := data.Manifest.checkAddress(fileName, line, header[0], row[0]); rowErr != nil {
+		return nil, rowErr
+	}
+
+	validator := field("validator")
+	if validator != "" {
+		if rowErr := data.Manifest.checkAddress(fileName, line, "validator", validator); rowErr != nil {
+			return nil, rowErr
+		}
+	}
+
+	return &Unbonding{
+		Delegator:      row[0],
+		Validator:      validator,
+		Amount:         amount,
+		CompletionTime: data.GenesisTime.Add(remaining),
+	}, nil
+}
+
+// Assign every unbonding entry to a recreated validator.
+//
+// Entries are resolved like delegations: by their own validator, then by the
+// validator map. Entries that still have no validator are credited to the
+// delegator as liquid coins.
+func resolveUnbondings(data *GenesisData, validatorMap map[string]string) error {
+	resolved := make([]*Unbonding, 0, len(data.Unbondings))
+	liquid := 0
+	for _, unbonding := range data.Unbondings {
+		delegation := &Delegation{Delegator: unbonding.Delegator, Validator: unbonding.Validator}
+		if validator, ok := lookupValidator(data, validatorMap, delegation); ok {
+			unbonding.Validator = validator
+			resolved = append(resolved, unbonding)
+			continue
+		}
+
+		if err := creditBond(data, unbonding.Delegator, unbonding.Source, unbonding.Amount); err != nil {
+			return err
+		}
+		liquid++
+	}
+	data.Unbondings = resolved
+
+	data.logf("Resolved %d unbonding entries, %d credited as liquid\n", len(resolved), liquid)
+
+	return nil
+}
+
+// Build one unbonding delegation per delegator and validator pair.
+//
+// Every entry is created at height zero, and its full balance is held by the
+// not-bonded pool until the completion time.
+func genesisUnbondingDelegations(data *GenesisData) ([]stakingtypes.UnbondingDelegation, error) {
+	type pair struct{ delegator, validator string }
+
+	entries := make(map[pair][]stakingtypes.UnbondingDelegationEntry)
+	for _, unbonding := range data.Unbondings {
+		key := pair{unbonding.Delegator, unbonding.Validator}
+		entries[key] = append(entries[key], stakingtypes.UnbondingDelegationEntry{
+			CreationHeight: 0,
+			CompletionTime: unbonding.CompletionTime,
+			InitialBalance: unbonding.Amount,
+			Balance:        unbonding.Amount,
+		})
+	}
+
+	keys := make([]pair, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].delegator != keys[j].delegator {
+			return keys[i].delegator < keys[j].delegator
+		}
+		return keys[i].validator < keys[j].validator
+	})
+
+	unbondingDelegations := make([]stakingtypes.UnbondingDelegation, 0, len(keys))
+	for _, key := range keys {
+		operator, err := data.Manifest.operatorAddress(key.validator)
+		if err != nil {
+			return nil, err
+		}
+
+		unbondingDelegations = append(unbondingDelegations, stakingtypes.UnbondingDelegation{
+			DelegatorAddress: key.delegator,
+			ValidatorAddress: operator,
+			Entries:          entries[key],
+		})
+	}
+
+	return unbondingDelegations, nil
+}
+
+// Sum the balances of all unbonding entries.
+func totalUnbonding(data *GenesisData) math.Int {
+	total := math.ZeroInt()
+	for _, unbonding := range data.Unbondings {
+		total = total.Add(unbonding.Amount)
+	}
+
+	return total
+}
@@ -0,0 +1,112 @@
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestProcessUnbondings(t *testing.T) {
+	t.Parallel()
+
+	const (
+		validator = "unicorn1qqyl24rxge02cgkqnq4p2340s28kdd89zld79f"
+		delegator = "unicorn1qqxm5thy3xjwwmz8re26d6kdme9y60jfrzhag3"
+	)
+
+	genesisTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	delegatorEnd := genesisTime.Add(24 * time.Hour)
+	// Entries without a remaining column take a full unbonding period
+	validatorEnd := genesisTime.Add(stakingtypes.DefaultUnbondingTime)
+
+	tests := []struct {
+		name       string
+		policy     string
+		expected   map[string]string    // Balances after processing
+		vesting    map[string]time.Time // End times of the vesting schedules
+		unbondings []string             // Recreated as "source delegator validator amount completion"
+	}{
+		{
+			name:     "liquid",
+			policy:   UnbondingPolicyLiquid,
+			expected: map[string]string{delegator: "100uwunicorn", validator: "50uwunicorn"},
+			vesting:  map[string]time.Time{},
+		},
+		{
+			name:     "vesting",
+			policy:   UnbondingPolicyVesting,
+			expected: map[string]string{delegator: "100uwunicorn", validator: "50uwunicorn"},
+			vesting:  map[string]time.Time{delegator: delegatorEnd, validator: validatorEnd},
+		},
+		{
+			// Self-unbondings default to the validator they are unbonding from
+			name:     "unbonding",
+			policy:   UnbondingPolicyUnbonding,
+			expected: map[string]string{},
+			vesting:  map[string]time.Time{},
+			unbondings: []string{
+				"kaway_unbond.csv " + delegator + " " + validator + " 100 " + delegatorEnd.Format(time.RFC3339),
+				"uwuval_unbond.csv " + validator + " " + validator + " 50 " + validatorEnd.Format(time.RFC3339),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "kaway_unbond.csv"),
+				[]byte("address,amount,validator,remaining\n"+delegator+",100,"+validator+",24h\n"+delegator+",0,"+validator+",1h\n"), 0o600))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "uwuval_unbond.csv"),
+				[]byte("address,amount\n"+validator+",50\n"), 0o600))
+
+			manifest := DefaultManifest()
+			manifest.Input.Dir = dir
+
+			data := &GenesisData{
+				Manifest:        manifest,
+				Accounts:        make(map[string]uint64),
+				Balances:        newLedger(),
+				UnbondingPolicy: tc.policy,
+				Vesting:         make(map[string]*VestingSchedule),
+				GenesisTime:     genesisTime,
+				RowErrors:       &RowErrors{},
+			}
+
+			require.NoError(t, processUnbondings(data))
+			require.Empty(t, data.RowErrors.Errors)
+			require.Contains(t, data.Accounts, delegator)
+			require.Contains(t, data.Accounts, validator)
+
+			balances := make(map[string]string)
+			for _, address := range data.Balances.addresses() {
+				balances[address] = data.Balances.balance(address).String()
+			}
+			require.Equal(t, tc.expected, balances)
+
+			vesting := make(map[string]time.Time, len(data.Vesting))
+			for address, schedule := range data.Vesting {
+				require.Equal(t, VestingTypeDelayed, schedule.Type)
+				require.Equal(t, balances[address], schedule.OriginalVesting.String())
+				vesting[address] = schedule.EndTime
+			}
+			require.Equal(t, tc.vesting, vesting)
+
+			var unbondings []string
+			for _, unbonding := range data.Unbondings {
+				unbondings = append(unbondings, strings.Join([]string{
+					unbonding.Source, unbonding.Delegator, unbonding.Validator,
+					unbonding.Amount.String(), unbonding.CompletionTime.Format(time.RFC3339),
+				}, " "))
+			}
+			require.Equal(t, tc.unbondings, unbondings)
+		})
+	}
+}
@@ -0,0 +1,404 @@
+package migrate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+const (
+	// VestingTypeDelayed vests every coin at the end time.
+	VestingTypeDelayed = "delayed"
+	// VestingTypeContinuous vests coins linearly between the start and end times.
+	VestingTypeContinuous = "continuous"
+	// VestingTypePeriodic vests a share of the coins at the end of each period.
+	VestingTypePeriodic = "periodic"
+)
+
+// VestingSchedule is the vesting applied to a migrated account.
+type VestingSchedule struct {
+	Type            string // VestingTypeDelayed, VestingTypeContinuous or VestingTypePeriodic
+	Source          string // The vesting policy schedule, or the unbond file that first locked coins
+	OriginalVesting sdk.Coins
+	StartTime       time.Time // Unused by delayed vesting
+	EndTime         time.Time
+	Periods         vestingtypes.Periods // Periodic vesting only
+}
+
+// VestingPolicy assigns vesting schedules to migrated accounts.
+type VestingPolicy struct {
+	Schedules []VestingPolicySchedule `yaml:"schedules"`
+}
+
+// VestingPolicySchedule is a single schedule of a vesting policy and the
+// accounts it applies to.
+//
+// Times are offsets from the genesis time, as Go durations such as 8760h, so
+// the policy does not need to change with the genesis time.
+type VestingPolicySchedule struct {
+	Name string `yaml:"name"`
+	Type string `yaml:"type"`
+
+	// Addresses lists accounts by address, under either prefix.
+	Addresses []string `yaml:"addresses"`
+	// TopHolders adds the largest holders of RankDenom not assigned by an
+	// earlier schedule, leaving out module accounts.
+	TopHolders int    `yaml:"top_holders"`
+	RankDenom  string `yaml:"rank_denom"`
+	// Denoms limits the vesting to these denoms; by default every coin of the account vests.
+	Denoms []string `yaml:"denoms"`
+
+	Start    string                `yaml:"start"`    // Continuous and periodic vesting only
+	Duration string                `yaml:"duration"` // Delayed and continuous vesting only
+	Periods  []VestingPolicyPeriod `yaml:"periods"`  // Periodic vesting only
+}
+
+// VestingPolicyPeriod is a period of a periodic schedule.
+type VestingPolicyPeriod struct {
+	Length string `yaml:"length"`
+	// Weight is the share of the vesting coins released at the end of the
+	// period, relative to the weights of the other periods.
+	Weight uint64 `yaml:"weight"`
+}
+
+// Lock coin in the account at address until endTime.
+//
+// An account holds a single delayed vesting schedule, so coins locked by
+// several entries all vest at the latest of their end times.
+func addDelayedVesting(data *GenesisData, address, source string, coin sdk.Coin, endTime time.Time) {
+	schedule, ok := data.Vesting[address]
+	if !ok {
+		schedule = &VestingSchedule{Type: VestingTypeDelayed, Source: source}
+		data.Vesting[address] = schedule
+	}
+
+	schedule.OriginalVesting = schedule.OriginalVesting.Add(coin)
+	if endTime.After(schedule.EndTime) {
+		schedule.EndTime = endTime
+	}
+}
+
+// Convert vesting addresses and denoms to the target chain.
+func convertVestingPrefixes(data *GenesisData) error {
+	converted := make(map[string]*VestingSchedule, len(data.Vesting))
+	for oldAddress, schedule := range data.Vesting {
+		newAddress, err := data.Manifest.convertAddress(oldAddress)
+		if err != nil {
+			return err
+		}
+
+		originalVesting := sdk.NewCoins()
+		for _, coin := range schedule.OriginalVesting {
+			denom, err := data.Manifest.convertDenom(coin.Denom)
+			if err != nil {
+				return err
+			}
+			originalVesting = originalVesting.Add(sdk.Coin{Denom: denom, Amount: coin.Amount})
+		}
+		schedule.OriginalVesting = originalVesting
+		converted[newAddress] = schedule
+	}
+	data.Vesting = converted
+
+	return nil
+}
+
+// Load a vesting policy file.
+func loadVestingPolicy(path string) (*VestingPolicy, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vesting policy: %w", err)
+	}
+
+	// Reject unknown fields, so a misspelled setting is not silently ignored
+	decoder := yaml.NewDecoder(bytes.NewReader(bz))
+	decoder.KnownFields(true)
+
+	policy := &VestingPolicy{}
+	if err := decoder.Decode(policy); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse vesting policy %s: %w", filepath.Base(path), err)
+	}
+
+	return policy, nil
+}
+
+// Assign the vesting schedules of the policy in policyPath to migrated accounts.
+//
+// Schedules are applied in order, and each account vests under at most one of
+// them. The coins that vest are those of the account's balance at genesis, so
+// the policy is applied once every balance is final.
+func applyVestingPolicy(data *GenesisData, policyPath string) error {
+	policy, err := loadVestingPolicy(policyPath)
+	if err != nil {
+		return err
+	}
+
+	assigned := make(map[string]string)
+	for i := range policy.Schedules {
+		schedule := &policy.Schedules[i]
+		if schedule.Name == "" {
+			return fmt.Errorf("vesting policy schedule %d needs a name", i+1)
+		}
+
+		addresses, err := vestingPolicyAddresses(data, schedule, assigned)
+		if err != nil {
+			return fmt.Errorf("vesting policy schedule %s: %w", schedule.Name, err)
+		}
+
+		vested := 0
+		for _, address := range addresses {
+			vestingSchedule, err := newPolicyVestingSchedule(data, schedule, data.Balances.balance(address))
+			if err != nil {
+				return fmt.Errorf("vesting policy schedule %s: %w", schedule.Name, err)
+			}
+			if vestingSchedule == nil {
+				continue
+			}
+
+			if existing, ok := data.Vesting[address]; ok {
+				return fmt.Errorf("vesting policy schedule %s: %s already vests under %s", schedule.Name, address, existing.Source)
+			}
+			data.Vesting[address] = vestingSchedule
+			vested++
+		}
+
+		data.logf("Vesting schedule %s: %d %s vesting accounts\n", schedule.Name, vested, schedule.Type)
+	}
+
+	return nil
+}
+
+// Return the accounts a schedule applies to: its listed addresses, then its
+// top holders.
+func vestingPolicyAddresses(data *GenesisData, schedule *VestingPolicySchedule, assigned map[string]string) ([]string, error) {
+	var addresses []string
+	for _, address := range schedule.Addresses {
+		converted, err := data.Manifest.convertAddress(address)
+		if err != nil {
+			return nil, err
+		}
+
+		if name, ok := assigned[converted]; ok {
+			return nil, fmt.Errorf("%s is already assigned to schedule %s", converted, name)
+		}
+		if _, ok := data.Accounts[converted]; !ok {
+			return nil, fmt.Errorf("%s is not a migrated account", converted)
+		}
+		if name, ok := data.ModuleAccounts[converted]; ok {
+			return nil, fmt.Errorf("%s is the %s module account, which cannot vest", converted, name)
+		}
+
+		assigned[converted] = schedule.Name
+		addresses = append(addresses, converted)
+	}
+
+	if schedule.TopHolders < 0 {
+		return nil, errors.New("top_holders must not be negative")
+	}
+	if schedule.TopHolders == 0 {
+		return addresses, nil
+	}
+
+	rankDenom := schedule.RankDenom
+	if rankDenom == "" {
+		rankDenom = BondDenom
+	}
+	rankDenom, err := data.Manifest.convertDenom(rankDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Module accounts, such as the staking pools, are often the largest
+	// holders, but they are migrated as module accounts and cannot vest
+	holders := make([]string, 0, len(data.Accounts))
+	for address := range data.Accounts {
+		if _, ok := assigned[address]; ok {
+			continue
+		}
+		if _, ok := data.ModuleAccounts[address]; ok {
+			continue
+		}
+		if data.Balances.balance(address).AmountOf(rankDenom).IsPositive() {
+			holders = append(holders, address)
+		}
+	}
+
+	// Largest first, ties broken by address so the selection is deterministic
+	sort.Slice(holders, func(i, j int) bool {
+		a := data.Balances.balance(holders[i]).AmountOf(rankDenom)
+		b := data.Balances.balance(holders[j]).AmountOf(rankDenom)
+		if !a.Equal(b) {
+			return a.GT(b)
+		}
+		return holders[i] < holders[j]
+	})
+
+	if len(holders) > schedule.TopHolders {
+		holders = holders[:schedule.TopHolders]
+	}
+	for _, address := range holders {
+		assigned[address] = schedule.Name
+	}
+
+	return append(addresses, holders...), nil
+}
+
+// Build the vesting schedule of an account under a policy schedule, or nil if
+// the account holds none of the coins that vest.
+func newPolicyVestingSchedule(data *GenesisData, schedule *VestingPolicySchedule, balance sdk.Coins) (*VestingSchedule, error) {
+	originalVesting := balance
+	if len(schedule.Denoms) > 0 {
+		originalVesting = sdk.NewCoins()
+		for _, denom := range schedule.Denoms {
+			converted, err := data.Manifest.convertDenom(denom)
+			if err != nil {
+				return nil, err
+			}
+			originalVesting = originalVesting.Add(sdk.Coin{Denom: converted, Amount: balance.AmountOf(converted)})
+		}
+	}
+
+	if originalVesting.IsZero() {
+		return nil, nil
+	}
+
+	start, err := parsePolicyDuration("start", schedule.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	vestingSchedule := &VestingSchedule{
+		Type:            schedule.Type,
+		Source:          schedule.Name,
+		OriginalVesting: originalVesting,
+		StartTime:       data.GenesisTime.Add(start),
+	}
+
+	switch schedule.Type {
+	case VestingTypeDelayed, VestingTypeContinuous:
+		if schedule.Type == VestingTypeDelayed && schedule.Start != "" {
+			return nil, errors.New("delayed vesting has no start")
+		}
+
+		duration, err := parsePolicyDuration("duration", schedule.Duration)
+		if err != nil {
+			return nil, err
+		}
+		if duration == 0 {
+			return nil, errors.New("duration must be set")
+		}
+		vestingSchedule.EndTime = vestingSchedule.StartTime.Add(duration)
+	case VestingTypePeriodic:
+		periods, err := splitVestingPeriods(schedule.Periods, originalVesting)
+		if err != nil {
+			return nil, err
+		}
+		vestingSchedule.Periods = periods
+	default:
+		return nil, fmt.Errorf("unknown vesting type %q, expected delayed, continuous or periodic", schedule.Type)
+	}
+
+	return vestingSchedule, nil
+}
+
+// Split the vesting coins over the periods in proportion to their weights.
+//
+// Each period releases the difference between the cumulative shares before
+// and after it, rounded down, so the periods always add up to exactly the
+// vesting coins.
+func splitVestingPeriods(policyPeriods []VestingPolicyPeriod, coins sdk.Coins) (vestingtypes.Periods, error) {
+	if len(policyPeriods) == 0 {
+		return nil, errors.New("periodic vesting needs periods")
+	}
+
+	totalWeight := uint64(0)
+	for _, period := range policyPeriods {
+		totalWeight += period.Weight
+	}
+	if totalWeight == 0 {
+		return nil, errors.New("periods need a positive total weight")
+	}
+
+	periods := make(vestingtypes.Periods, 0, len(policyPeriods))
+	released := sdk.NewCoins()
+	cumulativeWeight := uint64(0)
+	for _, policyPeriod := range policyPeriods {
+		length, err := parsePolicyDuration("period length", policyPeriod.Length)
+		if err != nil {
+			return nil, err
+		}
+		if length <= 0 {
+			return nil, errors.New("period length must be positive")
+		}
+
+		cumulativeWeight += policyPeriod.Weight
+
+		var amount sdk.Coins
+		for _, coin := range coins {
+			cumulative := coin.Amount.Mul(math.NewIntFromUint64(cumulativeWeight)).Quo(math.NewIntFromUint64(totalWeight))
+			amount = amount.Add(sdk.Coin{Denom: coin.Denom, Amount: cumulative.Sub(released.AmountOf(coin.Denom))})
+		}
+		released = released.Add(amount...)
+
+		periods = append(periods, vestingtypes.Period{Length: int64(length / time.Second), Amount: amount})
+	}
+
+	return periods, nil
+}
+
+// Parse an optional Go duration from a vesting policy, treating an empty value as zero.
+func parsePolicyDuration(field, value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", field, err)
+	}
+	if duration < 0 {
+		return 0, fmt.Errorf("invalid %s: must not be negative", field)
+	}
+
+	return duration, nil
+}
+
+// Wrap the base account in a vesting account if the address has a vesting schedule.
+func newGenesisAccount(base *authtypes.BaseAccount, schedule *VestingSchedule) (authtypes.GenesisAccount, error) {
+	if schedule == nil {
+		return base, nil
+	}
+
+	var (
+		account authtypes.GenesisAccount
+		err     error
+	)
+	switch schedule.Type {
+	case VestingTypeDelayed:
+		account, err = vestingtypes.NewDelayedVestingAccount(base, schedule.OriginalVesting, schedule.EndTime.Unix())
+	case VestingTypeContinuous:
+		account, err = vestingtypes.NewContinuousVestingAccount(base, schedule.OriginalVesting, schedule.StartTime.Unix(), schedule.EndTime.Unix())
+	case VestingTypePeriodic:
+		account, err = vestingtypes.NewPeriodicVestingAccount(base, schedule.OriginalVesting, schedule.StartTime.Unix(), schedule.Periods)
+	default:
+		err = fmt.Errorf("unknown vesting type %q", schedule.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid vesting for %s: %w", base.Address, err)
+	}
+
+	return account, nil
+}
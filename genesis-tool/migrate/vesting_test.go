@@ -0,0 +1,122 @@
+package migrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestSplitVestingPeriods(t *testing.T) {
+	t.Parallel()
+
+	coins := sdk.NewCoins(sdk.NewCoin("ugadikian", math.NewInt(100)), sdk.NewCoin("valgadikian", math.NewInt(10)))
+
+	tests := []struct {
+		name    string
+		periods []VestingPolicyPeriod
+		lengths []int64
+		amounts []string
+		err     string
+	}{
+		{
+			name:    "single period",
+			periods: []VestingPolicyPeriod{{Length: "720h", Weight: 1}},
+			lengths: []int64{2592000},
+			amounts: []string{"100ugadikian,10valgadikian"},
+		},
+		{
+			name:    "equal weights round down until the last period",
+			periods: []VestingPolicyPeriod{{Length: "24h", Weight: 1}, {Length: "24h", Weight: 1}, {Length: "24h", Weight: 1}},
+			lengths: []int64{86400, 86400, 86400},
+			amounts: []string{"33ugadikian,3valgadikian", "33ugadikian,3valgadikian", "34ugadikian,4valgadikian"},
+		},
+		{
+			name:    "uneven weights",
+			periods: []VestingPolicyPeriod{{Length: "1h", Weight: 1}, {Length: "2h", Weight: 3}},
+			lengths: []int64{3600, 7200},
+			amounts: []string{"25ugadikian,2valgadikian", "75ugadikian,8valgadikian"},
+		},
+		{name: "no periods", err: "needs periods"},
+		{name: "zero weights", periods: []VestingPolicyPeriod{{Length: "1h"}}, err: "positive total weight"},
+		{name: "zero length", periods: []VestingPolicyPeriod{{Weight: 1}}, err: "must be positive"},
+		{name: "bad length", periods: []VestingPolicyPeriod{{Length: "a month", Weight: 1}}, err: "invalid period length"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			periods, err := splitVestingPeriods(tc.periods, coins)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, periods, len(tc.amounts))
+
+			total := sdk.NewCoins()
+			for i, period := range periods {
+				require.Equal(t, tc.lengths[i], period.Length)
+				require.Equal(t, tc.amounts[i], period.Amount.String())
+				total = total.Add(period.Amount...)
+			}
+			require.Equal(t, coins.String(), total.String())
+		})
+	}
+}
+
+func TestVestingPolicyAddresses(t *testing.T) {
+	t.Parallel()
+
+	manifest := DefaultManifest()
+	small, err := manifest.convertAddress("unicorn1qqxm5thy3xjwwmz8re26d6kdme9y60jfrzhag3")
+	require.NoError(t, err)
+	large, err := manifest.convertAddress("unicorn1qqyl24rxge02cgkqnq4p2340s28kdd89zld79f")
+	require.NoError(t, err)
+	pool, err := manifest.moduleAddress(stakingtypes.BondedPoolName)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		schedule VestingPolicySchedule
+		expected []string
+		err      string
+	}{
+		{name: "top holders skip module accounts", schedule: VestingPolicySchedule{TopHolders: 1}, expected: []string{large}},
+		{name: "every holder", schedule: VestingPolicySchedule{TopHolders: 3}, expected: []string{large, small}},
+		{name: "address", schedule: VestingPolicySchedule{Addresses: []string{small}}, expected: []string{small}},
+		{name: "address and top holders", schedule: VestingPolicySchedule{Addresses: []string{small}, TopHolders: 1}, expected: []string{small, large}},
+		{name: "module account", schedule: VestingPolicySchedule{Addresses: []string{pool}}, err: "is the bonded_tokens_pool module account"},
+		{name: "negative top holders", schedule: VestingPolicySchedule{TopHolders: -1}, err: "must not be negative"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := &GenesisData{
+				Manifest:       manifest,
+				Accounts:       map[string]uint64{small: 0, large: 1, pool: 2},
+				Balances:       newLedger(),
+				ModuleAccounts: map[string]string{pool: stakingtypes.BondedPoolName},
+			}
+			require.NoError(t, data.Balances.addAmount(small, "balances.csv", "ugadikian", math.NewInt(10)))
+			require.NoError(t, data.Balances.addAmount(large, "balances.csv", "ugadikian", math.NewInt(20)))
+			require.NoError(t, data.Balances.addAmount(pool, stakingtypes.BondedPoolName, "ugadikian", math.NewInt(100)))
+
+			tc.schedule.Name = "team"
+			addresses, err := vestingPolicyAddresses(data, &tc.schedule, make(map[string]string))
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, addresses)
+		})
+	}
+}
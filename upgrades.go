@@ -3,15 +3,11 @@ package simapp
 import (
 	"github.com/unicorn-research/chain/upgrades"
 
-	storetypes "cosmossdk.io/store/types"
-
-	circuittypes "github.com/cosmos/cosmos-sdk/x/circuit/types"
-	consensusparamtypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
-	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
-// registerUpgradeHandlers registers all supported upgrade handlers.
+// registerUpgradeHandlers registers all supported upgrade handlers. Every
+// handler records that the articles of organization must be amended.
 func (app *SimApp) registerUpgradeHandlers() {
 	app.UpgradeKeeper.SetUpgradeHandler(
 		upgrades.V7,
@@ -22,12 +18,13 @@ func (app *SimApp) registerUpgradeHandlers() {
 			*app.IBCKeeper.ClientKeeper,
 			app.ConsensusParamsKeeper,
 			app.ParamsKeeper,
+			app.ArticlesKeeper,
 		),
 	)
 
 	app.UpgradeKeeper.SetUpgradeHandler(
 		upgrades.V7_1,
-		upgrades.CreateV7LocalhostUpgradeHandler(app.ModuleManager, app.configurator, *app.IBCKeeper.ClientKeeper),
+		upgrades.CreateV7LocalhostUpgradeHandler(app.ModuleManager, app.configurator, *app.IBCKeeper.ClientKeeper, app.ArticlesKeeper),
 	)
 
 	app.UpgradeKeeper.SetUpgradeHandler(
@@ -35,6 +32,7 @@ func (app *SimApp) registerUpgradeHandlers() {
 		upgrades.CreateDefaultUpgradeHandler(
 			app.ModuleManager,
 			app.configurator,
+			app.ArticlesKeeper,
 		),
 	)
 
@@ -43,6 +41,7 @@ func (app *SimApp) registerUpgradeHandlers() {
 		upgrades.CreateDefaultUpgradeHandler(
 			app.ModuleManager,
 			app.configurator,
+			app.ArticlesKeeper,
 		),
 	)
 
@@ -51,6 +50,16 @@ func (app *SimApp) registerUpgradeHandlers() {
 		upgrades.CreateDefaultUpgradeHandler(
 			app.ModuleManager,
 			app.configurator,
+			app.ArticlesKeeper,
+		),
+	)
+
+	app.UpgradeKeeper.SetUpgradeHandler(
+		upgrades.V11,
+		upgrades.CreateDefaultUpgradeHandler(
+			app.ModuleManager,
+			app.configurator,
+			app.ArticlesKeeper,
 		),
 	)
 
@@ -59,24 +68,7 @@ func (app *SimApp) registerUpgradeHandlers() {
 		panic(err)
 	}
 
-	if upgradeInfo.Name == upgrades.V7 && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		storeUpgrades := storetypes.StoreUpgrades{
-			Added: []string{
-				consensusparamtypes.StoreKey,
-				crisistypes.StoreKey,
-			},
-		}
-
-		// configure store loader that checks if version == upgradeHeight and applies store upgrades
-		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
-	}
-
-	if upgradeInfo.Name == upgrades.V8 && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		storeUpgrades := storetypes.StoreUpgrades{
-			Added: []string{
-				circuittypes.ModuleName,
-			},
-		}
+	if storeUpgrades, ok := upgrades.StoreUpgrades(upgradeInfo.Name); ok && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 	}
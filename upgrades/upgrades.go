@@ -2,12 +2,23 @@ package upgrades
 
 import (
 	"context"
+	"maps"
+	"slices"
+
+	articlestypes "github.com/unicorn-research/chain/x/articles/types"
+	dissolutiontypes "github.com/unicorn-research/chain/x/dissolution/types"
+	membershiptypes "github.com/unicorn-research/chain/x/membership/types"
+
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	circuittypes "github.com/cosmos/cosmos-sdk/x/circuit/types"
 	consensusparamskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
+	consensusparamtypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -28,16 +39,93 @@ const (
 	V8_1 = "v8.1"
 	// V10 defines the upgrade name for the ibc-go/v10 upgrade handler.
 	V10 = "v10"
+	// V11 defines the upgrade name for the upgrade adding the DAO modules.
+	V11 = "v11"
 )
 
+// ArticlesKeeper defines the articles keeper upgrade handlers record the
+// required articles amendments with.
+type ArticlesKeeper interface {
+	RecordAmendment(ctx context.Context, upgradeName string, fromVM, toVM map[string]uint64) (articlestypes.Amendment, error)
+}
+
+// StoreUpgrades returns the stores the named upgrade adds, renames or deletes,
+// if it changes any.
+func StoreUpgrades(upgradeName string) (storetypes.StoreUpgrades, bool) {
+	switch upgradeName {
+	case V7:
+		return storetypes.StoreUpgrades{
+			Added: []string{
+				consensusparamtypes.StoreKey,
+				crisistypes.StoreKey,
+			},
+		}, true
+	case V8:
+		return storetypes.StoreUpgrades{
+			Added: []string{
+				circuittypes.ModuleName,
+			},
+		}, true
+	case V11:
+		return storetypes.StoreUpgrades{
+			Added: []string{
+				articlestypes.StoreKey,
+				dissolutiontypes.StoreKey,
+				membershiptypes.StoreKey,
+			},
+		}, true
+	default:
+		return storetypes.StoreUpgrades{}, false
+	}
+}
+
+// recordArticlesAmendment wraps an upgrade handler so that a successful
+// upgrade records that the articles must be amended, since upgrades change the
+// DAO's smart contracts (W.S. 17-31-107(a)(iii)).
+//
+// Upgrades from before the articles store was added, which are only replayed,
+// have no articles to amend and record nothing. The version map cannot tell
+// them apart, since RunMigrations returns the version of every module of the
+// binary, so they are told apart by whether the articles module had a version
+// before the upgrade or the upgrade adds its store.
+func recordArticlesAmendment(articlesKeeper ArticlesKeeper, handler upgradetypes.UpgradeHandler) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// keep the versions before the upgrade, in case the handler updates them in place
+		oldVM := maps.Clone(fromVM)
+
+		toVM, err := handler(ctx, plan, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := oldVM[articlestypes.ModuleName]; !ok && !addsStore(plan.Name, articlestypes.StoreKey) {
+			return toVM, nil
+		}
+
+		if _, err := articlesKeeper.RecordAmendment(ctx, plan.Name, oldVM, toVM); err != nil {
+			return nil, err
+		}
+
+		return toVM, nil
+	}
+}
+
+// addsStore reports whether the named upgrade adds the store.
+func addsStore(upgradeName, storeKey string) bool {
+	storeUpgrades, ok := StoreUpgrades(upgradeName)
+	return ok && slices.Contains(storeUpgrades.Added, storeKey)
+}
+
+// CreateDefaultUpgradeHandler creates an upgrade handler for upgrades
 // that do not require special logic.
 func CreateDefaultUpgradeHandler(
 	mm *module.Manager,
 	configurator module.Configurator,
+	articlesKeeper ArticlesKeeper,
 ) upgradetypes.UpgradeHandler {
-	return func(ctx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+	return recordArticlesAmendment(articlesKeeper, func(ctx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
 		return mm.RunMigrations(ctx, configurator, vm)
-	}
+	})
 }
 
 // CreateV7UpgradeHandler creates an upgrade handler for the ibc-go/v7 SimApp upgrade.
@@ -48,8 +136,9 @@ func CreateV7UpgradeHandler(
 	clientKeeper clientkeeper.Keeper,
 	consensusParamsKeeper consensusparamskeeper.Keeper,
 	paramsKeeper paramskeeper.Keeper,
+	articlesKeeper ArticlesKeeper,
 ) upgradetypes.UpgradeHandler {
-	return func(goCtx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+	return recordArticlesAmendment(articlesKeeper, func(goCtx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
 		ctx := sdk.UnwrapSDKContext(goCtx)
 		// OPTIONAL: prune expired tendermint consensus states to save storage space
 		if _, err := ibctmmigrations.PruneExpiredConsensusStates(ctx, cdc, &clientKeeper); err != nil {
@@ -63,7 +152,7 @@ func CreateV7UpgradeHandler(
 		}
 
 		return mm.RunMigrations(goCtx, configurator, vm)
-	}
+	})
 }
 
 // CreateV7LocalhostUpgradeHandler creates an upgrade handler for the ibc-go/v7.1 SimApp upgrade.
@@ -71,8 +160,9 @@ func CreateV7LocalhostUpgradeHandler(
 	mm *module.Manager,
 	configurator module.Configurator,
 	clientKeeper clientkeeper.Keeper,
+	articlesKeeper ArticlesKeeper,
 ) upgradetypes.UpgradeHandler {
-	return func(goCtx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+	return recordArticlesAmendment(articlesKeeper, func(goCtx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
 		ctx := sdk.UnwrapSDKContext(goCtx)
 		// explicitly update the IBC 02-client params, adding the localhost client type
 		params := clientKeeper.GetParams(ctx)
@@ -80,5 +170,5 @@ func CreateV7LocalhostUpgradeHandler(
 		clientKeeper.SetParams(ctx, params)
 
 		return mm.RunMigrations(goCtx, configurator, vm)
-	}
+	})
 }
@@ -0,0 +1,128 @@
+package upgrades
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	articlestypes "github.com/unicorn-research/chain/x/articles/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+type mockArticlesKeeper struct {
+	upgradeName string
+	fromVM      map[string]uint64
+	toVM        map[string]uint64
+}
+
+func (k *mockArticlesKeeper) RecordAmendment(_ context.Context, upgradeName string, fromVM, toVM map[string]uint64) (articlestypes.Amendment, error) {
+	k.upgradeName, k.fromVM, k.toVM = upgradeName, fromVM, toVM
+	return articlestypes.Amendment{}, nil
+}
+
+func TestRecordArticlesAmendment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		plan     string
+		handler  upgradetypes.UpgradeHandler
+		fromVM   module.VersionMap
+		toVM     module.VersionMap
+		recorded bool
+		err      string
+	}{
+		{
+			name: "upgrade adding articles",
+			plan: V11,
+			handler: func(_ context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+				// Handlers may update the version map they are given
+				vm["gov"] = 6
+				vm["articles"] = 1
+				return vm, nil
+			},
+			fromVM:   module.VersionMap{"bank": 4, "gov": 5},
+			toVM:     module.VersionMap{"articles": 1, "bank": 4, "gov": 6},
+			recorded: true,
+		},
+		{
+			name: "upgrade after articles",
+			plan: "v12",
+			handler: func(_ context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+				vm["gov"] = 6
+				return vm, nil
+			},
+			fromVM:   module.VersionMap{"articles": 1, "bank": 4, "gov": 5},
+			toVM:     module.VersionMap{"articles": 1, "bank": 4, "gov": 6},
+			recorded: true,
+		},
+		{
+			// Upgrades from before the articles store was added have no
+			// articles to amend, though RunMigrations returns the version of
+			// every module of the binary
+			name: "upgrade before articles",
+			plan: V10,
+			handler: func(_ context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+				vm["ibc"] = 5
+				vm["articles"] = 1
+				return vm, nil
+			},
+			fromVM: module.VersionMap{"bank": 4, "ibc": 4},
+			toVM:   module.VersionMap{"articles": 1, "bank": 4, "ibc": 5},
+		},
+		{
+			name: "failed upgrade",
+			plan: "v12",
+			handler: func(context.Context, upgradetypes.Plan, module.VersionMap) (module.VersionMap, error) {
+				return nil, errors.New("migration failed")
+			},
+			fromVM: module.VersionMap{"articles": 1},
+			err:    "migration failed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			keeper := &mockArticlesKeeper{}
+			oldVM := maps.Clone(tc.fromVM)
+
+			toVM, err := recordArticlesAmendment(keeper, tc.handler)(context.Background(), upgradetypes.Plan{Name: tc.plan}, tc.fromVM)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				require.Empty(t, keeper.upgradeName)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.toVM, toVM)
+
+			if !tc.recorded {
+				require.Empty(t, keeper.upgradeName)
+				return
+			}
+			require.Equal(t, tc.plan, keeper.upgradeName)
+			require.Equal(t, map[string]uint64(oldVM), keeper.fromVM)
+			require.Equal(t, map[string]uint64(toVM), keeper.toVM)
+		})
+	}
+}
+
+func TestStoreUpgrades(t *testing.T) {
+	t.Parallel()
+
+	// The articles store is added by V11 alone
+	for _, name := range []string{V7, V7_1, V8, V8_1, V10, V11, "v12"} {
+		require.Equal(t, name == V11, addsStore(name, articlestypes.StoreKey), name)
+	}
+
+	_, ok := StoreUpgrades(V8_1)
+	require.False(t, ok)
+	storeUpgrades, ok := StoreUpgrades(V11)
+	require.True(t, ok)
+	require.Contains(t, storeUpgrades.Added, articlestypes.StoreKey)
+}
@@ -0,0 +1,60 @@
+package articles
+
+import (
+	"github.com/unicorn-research/chain/x/articles/types"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Articles",
+					Use:       "articles",
+					Short:     "Query the articles of organization in force",
+				},
+				{
+					RpcMethod:      "ArticlesVersion",
+					Use:            "version [version]",
+					Short:          "Query a version of the articles of organization",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "version"}},
+				},
+				{
+					RpcMethod: "History",
+					Use:       "history",
+					Short:     "Query every version of the articles of organization",
+				},
+				{
+					RpcMethod: "Amendments",
+					Use:       "amendments",
+					Short:     "Query the articles amendments software upgrades required",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"pending_only": {Name: "pending", Usage: "only return the amendments pending acknowledgement"},
+					},
+				},
+				{
+					RpcMethod: "Identifiers",
+					Use:       "identifiers",
+					Short:     "Query the chain's smart contract identifiers and how they differ from those the articles name",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "UpdateArticles",
+					Use:            "update-articles [document-hash] [llc-name]",
+					Short:          "Submit a proposal to register a new version of the articles of organization, acknowledging the pending amendments",
+					Example:        `update-articles 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 "Unicorn DAO LLC"`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "document_hash"}, {ProtoField: "llc_name"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/unicorn-research/chain/x/articles/types"
+)
+
+// InitGenesis initializes the articles module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState) error {
+	for _, articles := range genState.History {
+		if err := k.History.Set(ctx, articles.Version, articles); err != nil {
+			return err
+		}
+	}
+
+	for _, amendment := range genState.Amendments {
+		if err := k.Amendments.Set(ctx, amendment.Id, amendment); err != nil {
+			return err
+		}
+	}
+
+	return k.AmendmentID.Set(ctx, genState.NextAmendmentId)
+}
+
+// ExportGenesis returns the articles module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	var history []types.Articles
+	err := k.History.Walk(ctx, nil, func(_ uint64, articles types.Articles) (bool, error) {
+		history = append(history, articles)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var amendments []types.Amendment
+	err = k.Amendments.Walk(ctx, nil, func(_ uint64, amendment types.Amendment) (bool, error) {
+		amendments = append(amendments, amendment)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nextAmendmentID, err := k.AmendmentID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{History: history, Amendments: amendments, NextAmendmentId: nextAmendmentID}, nil
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/unicorn-research/chain/x/articles/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = queryServer{}
+
+type queryServer struct {
+	k Keeper
+}
+
+// NewQueryServerImpl returns an implementation of the articles QueryServer
+// interface for the provided Keeper.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k: k}
+}
+
+// Articles returns the articles in force.
+func (q queryServer) Articles(ctx context.Context, req *types.QueryArticlesRequest) (*types.QueryArticlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	articles, err := q.k.GetArticles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryArticlesResponse{Articles: articles}, nil
+}
+
+// ArticlesVersion returns a version of the articles.
+func (q queryServer) ArticlesVersion(ctx context.Context, req *types.QueryArticlesVersionRequest) (*types.QueryArticlesVersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	articles, err := q.k.GetArticlesVersion(ctx, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryArticlesVersionResponse{Articles: articles}, nil
+}
+
+// History returns every version of the articles, oldest first.
+func (q queryServer) History(ctx context.Context, req *types.QueryHistoryRequest) (*types.QueryHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	history, pageRes, err := query.CollectionPaginate(ctx, q.k.History, req.Pagination, func(_ uint64, articles types.Articles) (types.Articles, error) {
+		return articles, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHistoryResponse{History: history, Pagination: pageRes}, nil
+}
+
+// Amendments returns the amendments software upgrades required, oldest first.
+func (q queryServer) Amendments(ctx context.Context, req *types.QueryAmendmentsRequest) (*types.QueryAmendmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	amendments, pageRes, err := query.CollectionFilteredPaginate(ctx, q.k.Amendments, req.Pagination, func(_ uint64, amendment types.Amendment) (bool, error) {
+		return !req.PendingOnly || amendment.Pending(), nil
+	}, func(_ uint64, amendment types.Amendment) (types.Amendment, error) {
+		return amendment, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAmendmentsResponse{Amendments: amendments, Pagination: pageRes}, nil
+}
+
+// Identifiers returns the identifiers of the chain's smart contracts, and how
+// they differ from those the articles in force name.
+func (q queryServer) Identifiers(ctx context.Context, req *types.QueryIdentifiersRequest) (*types.QueryIdentifiersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	identifiers, err := q.k.Identifiers()
+	if err != nil {
+		return nil, err
+	}
+
+	// Before any articles are registered every identifier is added
+	var registered []types.Identifier
+	articles, err := q.k.GetArticles(ctx)
+	switch {
+	case err == nil:
+		registered = articles.Identifiers
+	case !errors.Is(err, types.ErrNoArticles):
+		return nil, err
+	}
+
+	added, removed := types.DiffIdentifiers(registered, identifiers)
+
+	return &types.QueryIdentifiersResponse{Identifiers: identifiers, Added: added, Removed: removed}, nil
+}
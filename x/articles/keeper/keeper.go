@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/unicorn-research/chain/x/articles/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the articles store.
+type Keeper struct {
+	ak       types.AccountKeeper
+	registry codectypes.InterfaceRegistry
+
+	// the address capable of amending the articles, usually the gov module account
+	authority string
+
+	Schema      collections.Schema
+	History     collections.Map[uint64, types.Articles]
+	Amendments  collections.Map[uint64, types.Amendment]
+	AmendmentID collections.Sequence
+}
+
+// NewKeeper constructs a new articles keeper. The interface registry lists
+// the messages the chain executes, which the articles name as identifiers.
+func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, ak types.AccountKeeper, registry codectypes.InterfaceRegistry, authority string) Keeper {
+	if _, err := ak.AddressCodec().StringToBytes(authority); err != nil {
+		panic(fmt.Errorf("invalid authority address: %w", err))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		ak:          ak,
+		registry:    registry,
+		authority:   authority,
+		History:     collections.NewMap(sb, types.HistoryKey, "history", collections.Uint64Key, codec.CollValue[types.Articles](cdc)),
+		Amendments:  collections.NewMap(sb, types.AmendmentsKey, "amendments", collections.Uint64Key, codec.CollValue[types.Amendment](cdc)),
+		AmendmentID: collections.NewSequence(sb, types.AmendmentIDKey, "amendment_id"),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the articles module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetArticles returns the articles in force, or ErrNoArticles when none
+// are registered.
+func (k Keeper) GetArticles(ctx context.Context) (types.Articles, error) {
+	iter, err := k.History.Iterate(ctx, new(collections.Range[uint64]).Descending())
+	if err != nil {
+		return types.Articles{}, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.Articles{}, types.ErrNoArticles
+	}
+
+	return iter.Value()
+}
+
+// GetArticlesVersion returns a version of the articles.
+func (k Keeper) GetArticlesVersion(ctx context.Context, version uint64) (types.Articles, error) {
+	articles, err := k.History.Get(ctx, version)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Articles{}, errorsmod.Wrapf(types.ErrNoArticles, "version %d", version)
+	}
+
+	return articles, err
+}
+
+// Identifiers returns the identifiers of the chain's smart contracts: the
+// addresses of the module accounts and the type URLs of the messages the
+// chain executes.
+func (k Keeper) Identifiers() ([]types.Identifier, error) {
+	permissions := k.ak.GetModulePermissions()
+	msgTypes := k.registry.ListImplementations(sdk.MsgInterfaceProtoName)
+
+	identifiers := make([]types.Identifier, 0, len(permissions)+len(msgTypes))
+	for name, permission := range permissions {
+		address, err := k.ak.AddressCodec().BytesToString(permission.GetAddress())
+		if err != nil {
+			return nil, err
+		}
+		identifiers = append(identifiers, types.Identifier{Type: types.IdentifierTypeModuleAccount, Name: name, Value: address})
+	}
+	for _, msgType := range msgTypes {
+		identifiers = append(identifiers, types.Identifier{Type: types.IdentifierTypeMessageType, Value: msgType})
+	}
+	types.SortIdentifiers(identifiers)
+
+	return identifiers, nil
+}
+
+// Amend registers a new version of the articles, naming the chain's current
+// identifiers, and acknowledges the pending amendments with it. It returns the
+// new version and the ids of the acknowledged amendments.
+func (k Keeper) Amend(ctx context.Context, documentHash, llcName string) (types.Articles, []uint64, error) {
+	version := uint64(1)
+	current, err := k.GetArticles(ctx)
+	switch {
+	case err == nil:
+		version = current.Version + 1
+	case !errors.Is(err, types.ErrNoArticles):
+		return types.Articles{}, nil, err
+	}
+
+	pending, err := k.PendingAmendments(ctx)
+	if err != nil {
+		return types.Articles{}, nil, err
+	}
+	if len(pending) > 0 && current.DocumentHash == documentHash {
+		return types.Articles{}, nil, errorsmod.Wrapf(types.ErrUnchangedDocument, "%d amendments pending since upgrade %s", len(pending), pending[0].UpgradeName)
+	}
+
+	identifiers, err := k.Identifiers()
+	if err != nil {
+		return types.Articles{}, nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	articles := types.Articles{
+		Version:      version,
+		DocumentHash: documentHash,
+		LlcName:      llcName,
+		Identifiers:  identifiers,
+		Height:       sdkCtx.BlockHeight(),
+		Time:         sdkCtx.BlockTime(),
+	}
+	if err := articles.Validate(); err != nil {
+		return types.Articles{}, nil, err
+	}
+
+	if err := k.History.Set(ctx, version, articles); err != nil {
+		return types.Articles{}, nil, err
+	}
+
+	acknowledged := make([]uint64, 0, len(pending))
+	for _, amendment := range pending {
+		amendment.ArticlesVersion = version
+		if err := k.Amendments.Set(ctx, amendment.Id, amendment); err != nil {
+			return types.Articles{}, nil, err
+		}
+		acknowledged = append(acknowledged, amendment.Id)
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventArticlesAmended{
+		Version:                articles.Version,
+		DocumentHash:           articles.DocumentHash,
+		LlcName:                articles.LlcName,
+		AcknowledgedAmendments: acknowledged,
+	}); err != nil {
+		return types.Articles{}, nil, err
+	}
+
+	return articles, acknowledged, nil
+}
+
+// RecordAmendment records that an upgrade changed the DAO's smart contracts,
+// which requires the articles to be amended. The amendment is pending until
+// governance registers a new version of the articles.
+func (k Keeper) RecordAmendment(ctx context.Context, upgradeName string, fromVM, toVM map[string]uint64) (types.Amendment, error) {
+	id, err := k.AmendmentID.Next(ctx)
+	if err != nil {
+		return types.Amendment{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	amendment := types.Amendment{
+		Id:           id,
+		UpgradeName:  upgradeName,
+		Height:       sdkCtx.BlockHeight(),
+		Time:         sdkCtx.BlockTime(),
+		FromVersions: types.NewModuleVersions(fromVM),
+		ToVersions:   types.NewModuleVersions(toVM),
+	}
+	if err := amendment.Validate(); err != nil {
+		return types.Amendment{}, err
+	}
+
+	if err := k.Amendments.Set(ctx, id, amendment); err != nil {
+		return types.Amendment{}, err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventArticlesAmendmentRequired{
+		Id:           amendment.Id,
+		UpgradeName:  amendment.UpgradeName,
+		FromVersions: amendment.FromVersions,
+		ToVersions:   amendment.ToVersions,
+	}); err != nil {
+		return types.Amendment{}, err
+	}
+
+	return amendment, nil
+}
+
+// PendingAmendments returns the amendments no articles acknowledged yet,
+// oldest first.
+func (k Keeper) PendingAmendments(ctx context.Context) ([]types.Amendment, error) {
+	var pending []types.Amendment
+	err := k.Amendments.Walk(ctx, nil, func(_ uint64, amendment types.Amendment) (bool, error) {
+		if amendment.Pending() {
+			pending = append(pending, amendment)
+		}
+		return false, nil
+	})
+
+	return pending, err
+}
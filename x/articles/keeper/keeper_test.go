@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/unicorn-research/chain/x/articles"
+	"github.com/unicorn-research/chain/x/articles/keeper"
+	"github.com/unicorn-research/chain/x/articles/types"
+
+	"cosmossdk.io/core/address"
+	storetypes "cosmossdk.io/store/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const documentHash = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+type mockAccountKeeper struct {
+	permissions map[string]authtypes.PermissionsForAddress
+}
+
+func (mockAccountKeeper) AddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec("cosmos")
+}
+
+func (ak mockAccountKeeper) GetModulePermissions() map[string]authtypes.PermissionsForAddress {
+	return ak.permissions
+}
+
+type fixture struct {
+	ctx       sdk.Context
+	keeper    keeper.Keeper
+	authority string
+}
+
+func setupFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(articles.AppModuleBasic{})
+
+	ak := mockAccountKeeper{permissions: map[string]authtypes.PermissionsForAddress{
+		govtypes.ModuleName: authtypes.NewPermissionsForAddress(govtypes.ModuleName, []string{authtypes.Burner}),
+	}}
+	authority, err := ak.AddressCodec().BytesToString(authtypes.NewModuleAddress(govtypes.ModuleName))
+	require.NoError(t, err)
+
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), ak, encCfg.InterfaceRegistry, authority)
+	ctx := testCtx.Ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+	require.NoError(t, k.InitGenesis(ctx, types.DefaultGenesisState()))
+
+	return &fixture{ctx: ctx, keeper: k, authority: authority}
+}
+
+func TestUpdateArticles(t *testing.T) {
+	t.Parallel()
+
+	f := setupFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	_, err := f.keeper.GetArticles(f.ctx)
+	require.ErrorIs(t, err, types.ErrNoArticles)
+
+	// Only the authority can amend the articles
+	_, err = msgServer.UpdateArticles(f.ctx, types.NewMsgUpdateArticles("cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysn3k7pr0", documentHash, "Unicorn DAO LLC"))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.UpdateArticles(f.ctx, types.NewMsgUpdateArticles(f.authority, "not a hash", "Unicorn DAO LLC"))
+	require.ErrorIs(t, err, types.ErrInvalidDocumentHash)
+
+	res, err := msgServer.UpdateArticles(f.ctx, types.NewMsgUpdateArticles(f.authority, documentHash, "Unicorn DAO LLC"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Version)
+
+	amendedHash := "60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"
+	res, err = msgServer.UpdateArticles(f.ctx.WithBlockHeight(20), types.NewMsgUpdateArticles(f.authority, amendedHash, "Unicorn DAO LLC"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Version)
+
+	current, err := f.keeper.GetArticles(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, amendedHash, current.DocumentHash)
+	require.Equal(t, int64(20), current.Height)
+
+	// The articles name the module accounts and the registered messages
+	require.Contains(t, current.Identifiers, types.Identifier{Type: types.IdentifierTypeModuleAccount, Name: govtypes.ModuleName, Value: f.authority})
+	require.Contains(t, current.Identifiers, types.Identifier{Type: types.IdentifierTypeMessageType, Value: "/unicorn.articles.v1.MsgUpdateArticles"})
+
+	// Earlier versions are kept
+	first, err := f.keeper.GetArticlesVersion(f.ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, documentHash, first.DocumentHash)
+	require.Equal(t, int64(10), first.Height)
+
+	_, err = f.keeper.GetArticlesVersion(f.ctx, 3)
+	require.ErrorIs(t, err, types.ErrNoArticles)
+
+	genState, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Len(t, genState.History, 2)
+	require.NoError(t, genState.Validate())
+}
+
+func TestQueryIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	f := setupFixture(t)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+
+	// Before registering articles every identifier is added
+	res, err := queryServer.Identifiers(f.ctx, &types.QueryIdentifiersRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Identifiers)
+	require.Equal(t, res.Identifiers, res.Added)
+	require.Empty(t, res.Removed)
+
+	_, _, err = f.keeper.Amend(f.ctx, documentHash, "Unicorn DAO LLC")
+	require.NoError(t, err)
+
+	res, err = queryServer.Identifiers(f.ctx, &types.QueryIdentifiersRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Added)
+	require.Empty(t, res.Removed)
+
+	history, err := queryServer.History(f.ctx, &types.QueryHistoryRequest{})
+	require.NoError(t, err)
+	require.Len(t, history.History, 1)
+}
+
+func TestRecordAmendment(t *testing.T) {
+	t.Parallel()
+
+	f := setupFixture(t)
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, types.DefaultGenesisState()))
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+
+	_, err := msgServer.UpdateArticles(f.ctx, types.NewMsgUpdateArticles(f.authority, documentHash, "Unicorn DAO LLC"))
+	require.NoError(t, err)
+
+	amendment, err := f.keeper.RecordAmendment(f.ctx, "v11", map[string]uint64{"bank": 4, "gov": 5}, map[string]uint64{"bank": 4, "gov": 6, "articles": 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), amendment.Id)
+	require.True(t, amendment.Pending())
+	require.Equal(t, []types.ModuleVersion{{Name: "articles", Version: 1}, {Name: "bank", Version: 4}, {Name: "gov", Version: 6}}, amendment.ToVersions)
+
+	pending, err := queryServer.Amendments(f.ctx, &types.QueryAmendmentsRequest{PendingOnly: true})
+	require.NoError(t, err)
+	require.Len(t, pending.Amendments, 1)
+
+	// The pending amendment must be acknowledged with a new articles document
+	_, err = msgServer.UpdateArticles(f.ctx, types.NewMsgUpdateArticles(f.authority, documentHash, "Unicorn DAO LLC"))
+	require.ErrorIs(t, err, types.ErrUnchangedDocument)
+
+	amendedHash := "60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"
+	res, err := msgServer.UpdateArticles(f.ctx, types.NewMsgUpdateArticles(f.authority, amendedHash, "Unicorn DAO LLC"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Version)
+	require.Equal(t, []uint64{1}, res.AcknowledgedAmendments)
+
+	pending, err = queryServer.Amendments(f.ctx, &types.QueryAmendmentsRequest{PendingOnly: true})
+	require.NoError(t, err)
+	require.Empty(t, pending.Amendments)
+
+	all, err := queryServer.Amendments(f.ctx, &types.QueryAmendmentsRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Amendments, 1)
+	require.Equal(t, uint64(2), all.Amendments[0].ArticlesVersion)
+
+	genState, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), genState.NextAmendmentId)
+	require.NoError(t, genState.Validate())
+}
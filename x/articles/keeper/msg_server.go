@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/unicorn-research/chain/x/articles/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the articles MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// UpdateArticles registers a new version of the articles, acknowledging the
+// pending amendments.
+func (k msgServer) UpdateArticles(ctx context.Context, msg *types.MsgUpdateArticles) (*types.MsgUpdateArticlesResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := types.ValidateDocumentHash(msg.DocumentHash); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateLLCName(msg.LlcName); err != nil {
+		return nil, err
+	}
+
+	articles, acknowledged, err := k.Amend(ctx, msg.DocumentHash, msg.LlcName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateArticlesResponse{Version: articles.Version, AcknowledgedAmendments: acknowledged}, nil
+}
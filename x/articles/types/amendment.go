@@ -0,0 +1,46 @@
+package types
+
+import (
+	"sort"
+
+	"cosmossdk.io/errors"
+)
+
+// NewModuleVersions returns the module versions of a version map, sorted by
+// module name.
+func NewModuleVersions(versionMap map[string]uint64) []ModuleVersion {
+	versions := make([]ModuleVersion, 0, len(versionMap))
+	for name, version := range versionMap {
+		versions = append(versions, ModuleVersion{Name: name, Version: version})
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Name < versions[j].Name })
+
+	return versions
+}
+
+// Pending returns whether no articles acknowledged the amendment yet.
+func (a Amendment) Pending() bool {
+	return a.ArticlesVersion == 0
+}
+
+// Validate performs a basic validation of the amendment.
+func (a Amendment) Validate() error {
+	if a.UpgradeName == "" {
+		return errors.Wrapf(ErrInvalidAmendment, "amendment %d has no upgrade name", a.Id)
+	}
+
+	for _, versions := range [][]ModuleVersion{a.FromVersions, a.ToVersions} {
+		seen := make(map[string]bool, len(versions))
+		for _, version := range versions {
+			if version.Name == "" {
+				return errors.Wrapf(ErrInvalidAmendment, "amendment %d has a module version without a name", a.Id)
+			}
+			if seen[version.Name] {
+				return errors.Wrapf(ErrInvalidAmendment, "amendment %d has duplicate versions of %s", a.Id, version.Name)
+			}
+			seen[version.Name] = true
+		}
+	}
+
+	return nil
+}
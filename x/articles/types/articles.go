@@ -0,0 +1,122 @@
+package types
+
+import (
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/errors"
+)
+
+// ValidateDocumentHash checks that hash is a hex encoded SHA-256 hash.
+func ValidateDocumentHash(hash string) error {
+	bz, err := hex.DecodeString(hash)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidDocumentHash, "%s is not hex encoded: %s", hash, err)
+	}
+	if len(bz) != 32 {
+		return errors.Wrapf(ErrInvalidDocumentHash, "%s is not a SHA-256 hash", hash)
+	}
+	if hash != strings.ToLower(hash) {
+		return errors.Wrapf(ErrInvalidDocumentHash, "%s is not lower case", hash)
+	}
+
+	return nil
+}
+
+// ValidateLLCName checks that name can be a registered LLC name.
+func ValidateLLCName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.Wrap(ErrInvalidLLCName, "name cannot be empty")
+	}
+	if strings.TrimSpace(name) != name {
+		return errors.Wrapf(ErrInvalidLLCName, "%q has leading or trailing spaces", name)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the articles.
+func (a Articles) Validate() error {
+	if a.Version == 0 {
+		return errors.Wrap(ErrInvalidVersion, "versions start at 1")
+	}
+	if err := ValidateDocumentHash(a.DocumentHash); err != nil {
+		return err
+	}
+	if err := ValidateLLCName(a.LlcName); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(a.Identifiers))
+	for _, identifier := range a.Identifiers {
+		if err := identifier.Validate(); err != nil {
+			return err
+		}
+		if seen[identifier.key()] {
+			return errors.Wrapf(ErrInvalidIdentifier, "duplicate identifier %s", identifier.Value)
+		}
+		seen[identifier.key()] = true
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the identifier.
+func (i Identifier) Validate() error {
+	if i.Value == "" {
+		return errors.Wrap(ErrInvalidIdentifier, "value cannot be empty")
+	}
+
+	switch i.Type {
+	case IdentifierTypeModuleAccount:
+		if i.Name == "" {
+			return errors.Wrapf(ErrInvalidIdentifier, "module account %s has no module name", i.Value)
+		}
+	case IdentifierTypeMessageType:
+		if !strings.HasPrefix(i.Value, "/") {
+			return errors.Wrapf(ErrInvalidIdentifier, "%s is not a type URL", i.Value)
+		}
+	default:
+		return errors.Wrapf(ErrInvalidIdentifier, "invalid type %s", i.Type)
+	}
+
+	return nil
+}
+
+func (i Identifier) key() string {
+	return i.Type.String() + "/" + i.Value
+}
+
+// SortIdentifiers sorts identifiers by type, then value.
+func SortIdentifiers(identifiers []Identifier) {
+	sort.Slice(identifiers, func(a, b int) bool {
+		if identifiers[a].Type != identifiers[b].Type {
+			return identifiers[a].Type < identifiers[b].Type
+		}
+		return identifiers[a].Value < identifiers[b].Value
+	})
+}
+
+// DiffIdentifiers returns the identifiers of current that registered does not
+// have, and those of registered that current does not have.
+func DiffIdentifiers(registered, current []Identifier) (added, removed []Identifier) {
+	inRegistered := make(map[string]bool, len(registered))
+	for _, identifier := range registered {
+		inRegistered[identifier.key()] = true
+	}
+	inCurrent := make(map[string]bool, len(current))
+	for _, identifier := range current {
+		inCurrent[identifier.key()] = true
+		if !inRegistered[identifier.key()] {
+			added = append(added, identifier)
+		}
+	}
+	for _, identifier := range registered {
+		if !inCurrent[identifier.key()] {
+			removed = append(removed, identifier)
+		}
+	}
+
+	return added, removed
+}
@@ -0,0 +1,2272 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: unicorn/articles/v1/articles.proto
+
+package types
+
+import (
+	_ "cosmossdk.io/api/amino"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IdentifierType is the kind of a smart contract identifier.
+type IdentifierType int32
+
+const (
+	// IDENTIFIER_TYPE_UNSPECIFIED is an invalid identifier type.
+	IdentifierTypeUnspecified IdentifierType = 0
+	// IDENTIFIER_TYPE_MODULE_ACCOUNT is the address of a module account.
+	IdentifierTypeModuleAccount IdentifierType = 1
+	// IDENTIFIER_TYPE_MESSAGE_TYPE is the type URL of a message the chain
+	// executes.
+	IdentifierTypeMessageType IdentifierType = 2
+)
+
+var IdentifierType_name = map[int32]string{
+	0: "IDENTIFIER_TYPE_UNSPECIFIED",
+	1: "IDENTIFIER_TYPE_MODULE_ACCOUNT",
+	2: "IDENTIFIER_TYPE_MESSAGE_TYPE",
+}
+
+var IdentifierType_value = map[string]int32{
+	"IDENTIFIER_TYPE_UNSPECIFIED":    0,
+	"IDENTIFIER_TYPE_MODULE_ACCOUNT": 1,
+	"IDENTIFIER_TYPE_MESSAGE_TYPE":   2,
+}
+
+func (x IdentifierType) String() string {
+	return proto.EnumName(IdentifierType_name, int32(x))
+}
+
+func (IdentifierType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e961dacd2d58d79c, []int{0}
+}
+
+// Articles is one version of the DAO's articles of organization.
+type Articles struct {
+	// version numbers the articles, starting at 1 and increasing with every
+	// amendment.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// document_hash is the hex encoded SHA-256 hash of the articles document.
+	DocumentHash string `protobuf:"bytes,2,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty"`
+	// llc_name is the name the DAO LLC is registered under.
+	LlcName string `protobuf:"bytes,3,opt,name=llc_name,json=llcName,proto3" json:"llc_name,omitempty"`
+	// identifiers are the publicly available identifiers of the smart contracts
+	// the DAO uses, which the articles must name.
+	Identifiers []Identifier `protobuf:"bytes,4,rep,name=identifiers,proto3" json:"identifiers"`
+	// height is the block height the version was registered at.
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time the version was registered at.
+	Time time.Time `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *Articles) Reset()         { *m = Articles{} }
+func (m *Articles) String() string { return proto.CompactTextString(m) }
+func (*Articles) ProtoMessage()    {}
+func (*Articles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e961dacd2d58d79c, []int{0}
+}
+func (m *Articles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Articles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Articles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Articles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Articles.Merge(m, src)
+}
+func (m *Articles) XXX_Size() int {
+	return m.Size()
+}
+func (m *Articles) XXX_DiscardUnknown() {
+	xxx_messageInfo_Articles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Articles proto.InternalMessageInfo
+
+func (m *Articles) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Articles) GetDocumentHash() string {
+	if m != nil {
+		return m.DocumentHash
+	}
+	return ""
+}
+
+func (m *Articles) GetLlcName() string {
+	if m != nil {
+		return m.LlcName
+	}
+	return ""
+}
+
+func (m *Articles) GetIdentifiers() []Identifier {
+	if m != nil {
+		return m.Identifiers
+	}
+	return nil
+}
+
+func (m *Articles) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Articles) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// Identifier is a publicly available identifier of a smart contract.
+type Identifier struct {
+	// type is the kind of the identifier.
+	Type IdentifierType `protobuf:"varint,1,opt,name=type,proto3,enum=unicorn.articles.v1.IdentifierType" json:"type,omitempty"`
+	// name is the name of the module owning a module account, and empty for
+	// message types.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// value is the module account address or the message type URL.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Identifier) Reset()         { *m = Identifier{} }
+func (m *Identifier) String() string { return proto.CompactTextString(m) }
+func (*Identifier) ProtoMessage()    {}
+func (*Identifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e961dacd2d58d79c, []int{1}
+}
+func (m *Identifier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Identifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Identifier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Identifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Identifier.Merge(m, src)
+}
+func (m *Identifier) XXX_Size() int {
+	return m.Size()
+}
+func (m *Identifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_Identifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Identifier proto.InternalMessageInfo
+
+func (m *Identifier) GetType() IdentifierType {
+	if m != nil {
+		return m.Type
+	}
+	return IdentifierTypeUnspecified
+}
+
+func (m *Identifier) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Identifier) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// ModuleVersion is the consensus version of a module.
+type ModuleVersion struct {
+	// name is the module name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the module's consensus version.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *ModuleVersion) Reset()         { *m = ModuleVersion{} }
+func (m *ModuleVersion) String() string { return proto.CompactTextString(m) }
+func (*ModuleVersion) ProtoMessage()    {}
+func (*ModuleVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e961dacd2d58d79c, []int{2}
+}
+func (m *ModuleVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleVersion.Merge(m, src)
+}
+func (m *ModuleVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
+
+func (m *ModuleVersion) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ModuleVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// Amendment records that a software upgrade changed the DAO's smart contracts,
+// which requires the articles to be amended. It is pending until governance
+// registers a new version of the articles.
+type Amendment struct {
+	// id is the unique identifier of the amendment.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// upgrade_name is the name of the upgrade plan that required the amendment.
+	UpgradeName string `protobuf:"bytes,2,opt,name=upgrade_name,json=upgradeName,proto3" json:"upgrade_name,omitempty"`
+	// height is the block height of the upgrade.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the upgrade.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// from_versions are the module versions before the upgrade.
+	FromVersions []ModuleVersion `protobuf:"bytes,5,rep,name=from_versions,json=fromVersions,proto3" json:"from_versions"`
+	// to_versions are the module versions after the upgrade.
+	ToVersions []ModuleVersion `protobuf:"bytes,6,rep,name=to_versions,json=toVersions,proto3" json:"to_versions"`
+	// articles_version is the version of the articles that acknowledged the
+	// amendment, and 0 while it is pending.
+	ArticlesVersion uint64 `protobuf:"varint,7,opt,name=articles_version,json=articlesVersion,proto3" json:"articles_version,omitempty"`
+}
+
+func (m *Amendment) Reset()         { *m = Amendment{} }
+func (m *Amendment) String() string { return proto.CompactTextString(m) }
+func (*Amendment) ProtoMessage()    {}
+func (*Amendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e961dacd2d58d79c, []int{3}
+}
+func (m *Amendment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Amendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Amendment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Amendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Amendment.Merge(m, src)
+}
+func (m *Amendment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Amendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Amendment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Amendment proto.InternalMessageInfo
+
+func (m *Amendment) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Amendment) GetUpgradeName() string {
+	if m != nil {
+		return m.UpgradeName
+	}
+	return ""
+}
+
+func (m *Amendment) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Amendment) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Amendment) GetFromVersions() []ModuleVersion {
+	if m != nil {
+		return m.FromVersions
+	}
+	return nil
+}
+
+func (m *Amendment) GetToVersions() []ModuleVersion {
+	if m != nil {
+		return m.ToVersions
+	}
+	return nil
+}
+
+func (m *Amendment) GetArticlesVersion() uint64 {
+	if m != nil {
+		return m.ArticlesVersion
+	}
+	return 0
+}
+
+// EventArticlesAmended is emitted when a new version of the articles is
+// registered.
+type EventArticlesAmended struct {
+	// version is the version of the new articles.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// document_hash is the hash of the new articles document.
+	DocumentHash string `protobuf:"bytes,2,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty"`
+	// llc_name is the registered LLC name of the new articles.
+	LlcName string `protobuf:"bytes,3,opt,name=llc_name,json=llcName,proto3" json:"llc_name,omitempty"`
+	// acknowledged_amendments are the ids of the pending amendments the new
+	// articles acknowledged.
+	AcknowledgedAmendments []uint64 `protobuf:"varint,4,rep,packed,name=acknowledged_amendments,json=acknowledgedAmendments,proto3" json:"acknowledged_amendments,omitempty"`
+}
+
+func (m *EventArticlesAmended) Reset()         { *m = EventArticlesAmended{} }
+func (m *EventArticlesAmended) String() string { return proto.CompactTextString(m) }
+func (*EventArticlesAmended) ProtoMessage()    {}
+func (*EventArticlesAmended) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e961dacd2d58d79c, []int{4}
+}
+func (m *EventArticlesAmended) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventArticlesAmended) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventArticlesAmended.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventArticlesAmended) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventArticlesAmended.Merge(m, src)
+}
+func (m *EventArticlesAmended) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventArticlesAmended) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventArticlesAmended.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventArticlesAmended proto.InternalMessageInfo
+
+func (m *EventArticlesAmended) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *EventArticlesAmended) GetDocumentHash() string {
+	if m != nil {
+		return m.DocumentHash
+	}
+	return ""
+}
+
+func (m *EventArticlesAmended) GetLlcName() string {
+	if m != nil {
+		return m.LlcName
+	}
+	return ""
+}
+
+func (m *EventArticlesAmended) GetAcknowledgedAmendments() []uint64 {
+	if m != nil {
+		return m.AcknowledgedAmendments
+	}
+	return nil
+}
+
+// EventArticlesAmendmentRequired is emitted when a software upgrade requires
+// the articles to be amended.
+type EventArticlesAmendmentRequired struct {
+	// id is the id of the amendment.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// upgrade_name is the name of the upgrade plan.
+	UpgradeName string `protobuf:"bytes,2,opt,name=upgrade_name,json=upgradeName,proto3" json:"upgrade_name,omitempty"`
+	// from_versions are the module versions before the upgrade.
+	FromVersions []ModuleVersion `protobuf:"bytes,3,rep,name=from_versions,json=fromVersions,proto3" json:"from_versions"`
+	// to_versions are the module versions after the upgrade.
+	ToVersions []ModuleVersion `protobuf:"bytes,4,rep,name=to_versions,json=toVersions,proto3" json:"to_versions"`
+}
+
+func (m *EventArticlesAmendmentRequired) Reset()         { *m = EventArticlesAmendmentRequired{} }
+func (m *EventArticlesAmendmentRequired) String() string { return proto.CompactTextString(m) }
+func (*EventArticlesAmendmentRequired) ProtoMessage()    {}
+func (*EventArticlesAmendmentRequired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e961dacd2d58d79c, []int{5}
+}
+func (m *EventArticlesAmendmentRequired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventArticlesAmendmentRequired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventArticlesAmendmentRequired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventArticlesAmendmentRequired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventArticlesAmendmentRequired.Merge(m, src)
+}
+func (m *EventArticlesAmendmentRequired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventArticlesAmendmentRequired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventArticlesAmendmentRequired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventArticlesAmendmentRequired proto.InternalMessageInfo
+
+func (m *EventArticlesAmendmentRequired) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventArticlesAmendmentRequired) GetUpgradeName() string {
+	if m != nil {
+		return m.UpgradeName
+	}
+	return ""
+}
+
+func (m *EventArticlesAmendmentRequired) GetFromVersions() []ModuleVersion {
+	if m != nil {
+		return m.FromVersions
+	}
+	return nil
+}
+
+func (m *EventArticlesAmendmentRequired) GetToVersions() []ModuleVersion {
+	if m != nil {
+		return m.ToVersions
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("unicorn.articles.v1.IdentifierType", IdentifierType_name, IdentifierType_value)
+	proto.RegisterType((*Articles)(nil), "unicorn.articles.v1.Articles")
+	proto.RegisterType((*Identifier)(nil), "unicorn.articles.v1.Identifier")
+	proto.RegisterType((*ModuleVersion)(nil), "unicorn.articles.v1.ModuleVersion")
+	proto.RegisterType((*Amendment)(nil), "unicorn.articles.v1.Amendment")
+	proto.RegisterType((*EventArticlesAmended)(nil), "unicorn.articles.v1.EventArticlesAmended")
+	proto.RegisterType((*EventArticlesAmendmentRequired)(nil), "unicorn.articles.v1.EventArticlesAmendmentRequired")
+}
+
+func init() {
+	proto.RegisterFile("unicorn/articles/v1/articles.proto", fileDescriptor_e961dacd2d58d79c)
+}
+
+var fileDescriptor_e961dacd2d58d79c = []byte{
+	// 729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0xce, 0x26, 0x6e, 0xda, 0x6e, 0x9a, 0xbe, 0x79, 0xf7, 0xad, 0xfa, 0xba, 0x29, 0x38, 0xc1,
+	0xbd, 0x84, 0x4a, 0xd8, 0x6a, 0x38, 0xf4, 0x54, 0x50, 0x9a, 0x1a, 0x88, 0xd4, 0xa6, 0x95, 0x9b,
+	0x20, 0xc1, 0xc5, 0x72, 0xed, 0xad, 0x6d, 0x61, 0x7b, 0x83, 0x3f, 0x02, 0xfd, 0x07, 0xd0, 0x53,
+	0xff, 0x40, 0x4f, 0x5c, 0xe0, 0xc6, 0xcf, 0xe8, 0xb1, 0x47, 0x0e, 0x08, 0x50, 0x8b, 0xc4, 0x4f,
+	0xe0, 0x8a, 0xbc, 0xb6, 0xf3, 0x41, 0x23, 0x21, 0x15, 0xb8, 0x58, 0x33, 0xb3, 0x33, 0x8f, 0x67,
+	0x9e, 0x67, 0xc7, 0x86, 0x7c, 0xe8, 0x5a, 0x1a, 0xf1, 0x5c, 0x51, 0xf5, 0x02, 0x4b, 0xb3, 0xb1,
+	0x2f, 0xf6, 0xd7, 0x06, 0xb6, 0xd0, 0xf3, 0x48, 0x40, 0xd0, 0x7f, 0x49, 0x8e, 0x30, 0x88, 0xf7,
+	0xd7, 0xca, 0xff, 0xaa, 0x8e, 0xe5, 0x12, 0x91, 0x3e, 0xe3, 0xbc, 0xf2, 0x82, 0x41, 0x0c, 0x42,
+	0x4d, 0x31, 0xb2, 0x92, 0x68, 0xc5, 0x20, 0xc4, 0xb0, 0xb1, 0x48, 0xbd, 0x83, 0xf0, 0x50, 0x0c,
+	0x2c, 0x07, 0xfb, 0x81, 0xea, 0xf4, 0xe2, 0x04, 0xfe, 0x75, 0x16, 0xce, 0x34, 0x12, 0x64, 0xc4,
+	0xc2, 0xe9, 0x3e, 0xf6, 0x7c, 0x8b, 0xb8, 0x2c, 0xa8, 0x82, 0x1a, 0x23, 0xa7, 0x2e, 0x5a, 0x81,
+	0x45, 0x9d, 0x68, 0xa1, 0x83, 0xdd, 0x40, 0x31, 0x55, 0xdf, 0x64, 0xb3, 0x55, 0x50, 0x9b, 0x95,
+	0xe7, 0xd2, 0xe0, 0x23, 0xd5, 0x37, 0xd1, 0x12, 0x9c, 0xb1, 0x6d, 0x4d, 0x71, 0x55, 0x07, 0xb3,
+	0x39, 0x7a, 0x3e, 0x6d, 0xdb, 0x5a, 0x5b, 0x75, 0x30, 0xda, 0x86, 0x05, 0x4b, 0xc7, 0x6e, 0x60,
+	0x1d, 0x5a, 0xd8, 0xf3, 0x59, 0xa6, 0x9a, 0xab, 0x15, 0xea, 0x15, 0x61, 0xc2, 0x6c, 0x42, 0x6b,
+	0x90, 0xb7, 0x39, 0x7b, 0xf6, 0xa9, 0x92, 0x79, 0xfb, 0xed, 0xfd, 0x2a, 0x90, 0x47, 0xcb, 0xd1,
+	0x22, 0xcc, 0x9b, 0xd8, 0x32, 0xcc, 0x80, 0x9d, 0xaa, 0x82, 0x5a, 0x4e, 0x4e, 0x3c, 0xb4, 0x01,
+	0x99, 0x68, 0x3e, 0x36, 0x5f, 0x05, 0xb5, 0x42, 0xbd, 0x2c, 0xc4, 0xc3, 0x0b, 0xe9, 0xf0, 0x42,
+	0x27, 0x1d, 0x7e, 0xb3, 0x18, 0x21, 0x9f, 0x7c, 0xae, 0x80, 0x18, 0x9d, 0x96, 0xf1, 0x04, 0xc2,
+	0xe1, 0xcb, 0xd1, 0x3a, 0x64, 0x82, 0xa3, 0x1e, 0xa6, 0x4c, 0xcc, 0xd7, 0x57, 0x7e, 0xd1, 0x6b,
+	0xe7, 0xa8, 0x87, 0x65, 0x5a, 0x80, 0x10, 0x64, 0x28, 0x05, 0x31, 0x45, 0xd4, 0x46, 0x0b, 0x70,
+	0xaa, 0xaf, 0xda, 0x61, 0xca, 0x4b, 0xec, 0xf0, 0x1b, 0xb0, 0xb8, 0x43, 0xf4, 0xd0, 0xc6, 0x8f,
+	0x13, 0x9a, 0xd3, 0x52, 0x30, 0x52, 0x3a, 0x22, 0x4a, 0x76, 0x4c, 0x14, 0xfe, 0x6b, 0x16, 0xce,
+	0x36, 0x1c, 0xec, 0xea, 0x91, 0x02, 0x68, 0x1e, 0x66, 0x2d, 0x3d, 0xd1, 0x2d, 0x6b, 0xe9, 0xe8,
+	0x16, 0x9c, 0x0b, 0x7b, 0x86, 0xa7, 0xea, 0x58, 0x19, 0x69, 0xa7, 0x90, 0xc4, 0xa8, 0x2a, 0x43,
+	0x1e, 0x73, 0x13, 0x79, 0x64, 0xae, 0xc5, 0x23, 0x92, 0x61, 0xf1, 0xd0, 0x23, 0x8e, 0x92, 0xf4,
+	0xe9, 0xb3, 0x53, 0x54, 0x6e, 0x7e, 0x22, 0x85, 0x63, 0x04, 0x8c, 0x2a, 0x3e, 0x17, 0x61, 0x24,
+	0x71, 0x1f, 0xb5, 0x61, 0x21, 0x20, 0x43, 0xc4, 0xfc, 0x75, 0x10, 0x61, 0x40, 0x06, 0x78, 0xb7,
+	0x61, 0x29, 0xad, 0x49, 0x51, 0xd9, 0x69, 0xca, 0xdd, 0x3f, 0x69, 0x3c, 0xc9, 0xe5, 0xdf, 0x01,
+	0xb8, 0x20, 0xf5, 0xb1, 0x1b, 0xa4, 0x7b, 0x42, 0x39, 0xc7, 0xfa, 0x5f, 0x5c, 0x97, 0x75, 0xf8,
+	0xbf, 0xaa, 0x3d, 0x73, 0xc9, 0x0b, 0x1b, 0xeb, 0x06, 0xd6, 0x15, 0x35, 0x55, 0x39, 0x5e, 0x1d,
+	0x46, 0x5e, 0x1c, 0x3d, 0x1e, 0xdc, 0x01, 0x9f, 0xff, 0x0e, 0x20, 0x77, 0xb5, 0xd7, 0xe8, 0x4c,
+	0xc6, 0xcf, 0x43, 0xcb, 0xc3, 0xfa, 0x75, 0xee, 0xc9, 0x15, 0x41, 0x73, 0x7f, 0x5c, 0x50, 0xe6,
+	0x37, 0x05, 0x5d, 0xfd, 0x08, 0xe0, 0xfc, 0xf8, 0x3a, 0xa2, 0x7b, 0x70, 0xb9, 0xb5, 0x25, 0xb5,
+	0x3b, 0xad, 0x07, 0x2d, 0x49, 0x56, 0x3a, 0x4f, 0xf6, 0x24, 0xa5, 0xdb, 0xde, 0xdf, 0x93, 0x9a,
+	0x51, 0x60, 0xab, 0x94, 0x29, 0xdf, 0x3c, 0x3e, 0xad, 0x2e, 0x8d, 0x17, 0x75, 0x5d, 0xbf, 0x87,
+	0xb5, 0xc8, 0xd5, 0x51, 0x13, 0x72, 0x3f, 0xd7, 0xef, 0xec, 0x6e, 0x75, 0xb7, 0x25, 0xa5, 0xd1,
+	0x6c, 0xee, 0x76, 0xdb, 0x9d, 0x12, 0x28, 0x57, 0x8e, 0x4f, 0xab, 0xcb, 0xe3, 0x10, 0x71, 0xbb,
+	0x0d, 0x4d, 0x23, 0xa1, 0x1b, 0xa0, 0xfb, 0xf0, 0xc6, 0x15, 0x10, 0x69, 0x7f, 0xbf, 0xf1, 0x50,
+	0xa2, 0x4e, 0x29, 0x3b, 0xa9, 0x8b, 0x1d, 0xec, 0xfb, 0xaa, 0x81, 0x23, 0xb3, 0xcc, 0xbc, 0x7a,
+	0xc3, 0x65, 0x36, 0xb7, 0xcf, 0x2e, 0x38, 0x70, 0x7e, 0xc1, 0x81, 0x2f, 0x17, 0x1c, 0x38, 0xb9,
+	0xe4, 0x32, 0xe7, 0x97, 0x5c, 0xe6, 0xc3, 0x25, 0x97, 0x79, 0x5a, 0x37, 0xac, 0xc0, 0x0c, 0x0f,
+	0x04, 0x8d, 0x38, 0x62, 0xc2, 0xde, 0x1d, 0x0f, 0xfb, 0x58, 0xf5, 0x34, 0x53, 0xd4, 0x4c, 0xd5,
+	0x72, 0xc5, 0x97, 0xc3, 0x1f, 0x4c, 0xf4, 0x85, 0xf2, 0x0f, 0xf2, 0x74, 0x95, 0xef, 0xfe, 0x18,
+	0x00, 0xf9, 0x10, 0xca, 0x84, 0x81, 0x06, 0x00, 0x00,
+}
+
+func (m *Articles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Articles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Articles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintArticles(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.Height != 0 {
+		i = encodeVarintArticles(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Identifiers) > 0 {
+		for iNdEx := len(m.Identifiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Identifiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintArticles(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LlcName) > 0 {
+		i -= len(m.LlcName)
+		copy(dAtA[i:], m.LlcName)
+		i = encodeVarintArticles(dAtA, i, uint64(len(m.LlcName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DocumentHash) > 0 {
+		i -= len(m.DocumentHash)
+		copy(dAtA[i:], m.DocumentHash)
+		i = encodeVarintArticles(dAtA, i, uint64(len(m.DocumentHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintArticles(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Identifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Identifier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Identifier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintArticles(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintArticles(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintArticles(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintArticles(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintArticles(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Amendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Amendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Amendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ArticlesVersion != 0 {
+		i = encodeVarintArticles(dAtA, i, uint64(m.ArticlesVersion))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ToVersions) > 0 {
+		for iNdEx := len(m.ToVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ToVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintArticles(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FromVersions) > 0 {
+		for iNdEx := len(m.FromVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FromVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintArticles(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintArticles(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintArticles(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.UpgradeName) > 0 {
+		i -= len(m.UpgradeName)
+		copy(dAtA[i:], m.UpgradeName)
+		i = encodeVarintArticles(dAtA, i, uint64(len(m.UpgradeName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintArticles(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventArticlesAmended) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventArticlesAmended) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventArticlesAmended) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AcknowledgedAmendments) > 0 {
+		dAtA4 := make([]byte, len(m.AcknowledgedAmendments)*10)
+		var j3 int
+		for _, num := range m.AcknowledgedAmendments {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintArticles(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LlcName) > 0 {
+		i -= len(m.LlcName)
+		copy(dAtA[i:], m.LlcName)
+		i = encodeVarintArticles(dAtA, i, uint64(len(m.LlcName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DocumentHash) > 0 {
+		i -= len(m.DocumentHash)
+		copy(dAtA[i:], m.DocumentHash)
+		i = encodeVarintArticles(dAtA, i, uint64(len(m.DocumentHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintArticles(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventArticlesAmendmentRequired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventArticlesAmendmentRequired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventArticlesAmendmentRequired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToVersions) > 0 {
+		for iNdEx := len(m.ToVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ToVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintArticles(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FromVersions) > 0 {
+		for iNdEx := len(m.FromVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FromVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintArticles(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.UpgradeName) > 0 {
+		i -= len(m.UpgradeName)
+		copy(dAtA[i:], m.UpgradeName)
+		i = encodeVarintArticles(dAtA, i, uint64(len(m.UpgradeName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintArticles(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintArticles(dAtA []byte, offset int, v uint64) int {
+	offset -= sovArticles(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Articles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovArticles(uint64(m.Version))
+	}
+	l = len(m.DocumentHash)
+	if l > 0 {
+		n += 1 + l + sovArticles(uint64(l))
+	}
+	l = len(m.LlcName)
+	if l > 0 {
+		n += 1 + l + sovArticles(uint64(l))
+	}
+	if len(m.Identifiers) > 0 {
+		for _, e := range m.Identifiers {
+			l = e.Size()
+			n += 1 + l + sovArticles(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovArticles(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovArticles(uint64(l))
+	return n
+}
+
+func (m *Identifier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovArticles(uint64(m.Type))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovArticles(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovArticles(uint64(l))
+	}
+	return n
+}
+
+func (m *ModuleVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovArticles(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovArticles(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *Amendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovArticles(uint64(m.Id))
+	}
+	l = len(m.UpgradeName)
+	if l > 0 {
+		n += 1 + l + sovArticles(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovArticles(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovArticles(uint64(l))
+	if len(m.FromVersions) > 0 {
+		for _, e := range m.FromVersions {
+			l = e.Size()
+			n += 1 + l + sovArticles(uint64(l))
+		}
+	}
+	if len(m.ToVersions) > 0 {
+		for _, e := range m.ToVersions {
+			l = e.Size()
+			n += 1 + l + sovArticles(uint64(l))
+		}
+	}
+	if m.ArticlesVersion != 0 {
+		n += 1 + sovArticles(uint64(m.ArticlesVersion))
+	}
+	return n
+}
+
+func (m *EventArticlesAmended) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovArticles(uint64(m.Version))
+	}
+	l = len(m.DocumentHash)
+	if l > 0 {
+		n += 1 + l + sovArticles(uint64(l))
+	}
+	l = len(m.LlcName)
+	if l > 0 {
+		n += 1 + l + sovArticles(uint64(l))
+	}
+	if len(m.AcknowledgedAmendments) > 0 {
+		l = 0
+		for _, e := range m.AcknowledgedAmendments {
+			l += sovArticles(uint64(e))
+		}
+		n += 1 + sovArticles(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventArticlesAmendmentRequired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovArticles(uint64(m.Id))
+	}
+	l = len(m.UpgradeName)
+	if l > 0 {
+		n += 1 + l + sovArticles(uint64(l))
+	}
+	if len(m.FromVersions) > 0 {
+		for _, e := range m.FromVersions {
+			l = e.Size()
+			n += 1 + l + sovArticles(uint64(l))
+		}
+	}
+	if len(m.ToVersions) > 0 {
+		for _, e := range m.ToVersions {
+			l = e.Size()
+			n += 1 + l + sovArticles(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovArticles(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozArticles(x uint64) (n int) {
+	return sovArticles(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Articles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArticles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Articles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Articles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArticles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LlcName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArticles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LlcName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArticles
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifiers = append(m.Identifiers, Identifier{})
+			if err := m.Identifiers[len(m.Identifiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArticles
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArticles(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Identifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArticles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Identifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Identifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= IdentifierType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArticles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArticles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArticles(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArticles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArticles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArticles(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Amendment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArticles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Amendment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Amendment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArticles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArticles
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArticles
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromVersions = append(m.FromVersions, ModuleVersion{})
+			if err := m.FromVersions[len(m.FromVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArticles
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToVersions = append(m.ToVersions, ModuleVersion{})
+			if err := m.ToVersions[len(m.ToVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArticlesVersion", wireType)
+			}
+			m.ArticlesVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArticlesVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArticles(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventArticlesAmended) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArticles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventArticlesAmended: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventArticlesAmended: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArticles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LlcName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArticles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LlcName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArticles
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AcknowledgedAmendments = append(m.AcknowledgedAmendments, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArticles
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthArticles
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthArticles
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AcknowledgedAmendments) == 0 {
+					m.AcknowledgedAmendments = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowArticles
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AcknowledgedAmendments = append(m.AcknowledgedAmendments, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedAmendments", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArticles(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventArticlesAmendmentRequired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArticles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventArticlesAmendmentRequired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventArticlesAmendmentRequired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArticles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArticles
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromVersions = append(m.FromVersions, ModuleVersion{})
+			if err := m.FromVersions[len(m.FromVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArticles
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToVersions = append(m.ToVersions, ModuleVersion{})
+			if err := m.ToVersions[len(m.ToVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArticles(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArticles
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipArticles(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowArticles
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowArticles
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthArticles
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupArticles
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthArticles
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthArticles        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowArticles          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupArticles = fmt.Errorf("proto: unexpected end of group")
+)
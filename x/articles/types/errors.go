@@ -0,0 +1,18 @@
+package types
+
+import (
+	"google.golang.org/grpc/codes"
+
+	"cosmossdk.io/errors"
+)
+
+// x/articles module sentinel errors.
+var (
+	ErrInvalidDocumentHash = errors.Register(ModuleName, 2, "invalid articles document hash")
+	ErrInvalidLLCName      = errors.Register(ModuleName, 3, "invalid LLC name")
+	ErrInvalidIdentifier   = errors.Register(ModuleName, 4, "invalid identifier")
+	ErrInvalidVersion      = errors.Register(ModuleName, 5, "invalid articles version")
+	ErrNoArticles          = errors.RegisterWithGRPCCode(ModuleName, 6, codes.NotFound, "no articles registered")
+	ErrInvalidAmendment    = errors.Register(ModuleName, 7, "invalid amendment")
+	ErrUnchangedDocument   = errors.Register(ModuleName, 8, "pending amendments require a new articles document")
+)
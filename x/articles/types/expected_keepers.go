@@ -0,0 +1,14 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountKeeper defines the expected account keeper, whose module accounts
+// are identifiers of the DAO's smart contracts.
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+	GetModulePermissions() map[string]authtypes.PermissionsForAddress
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// DefaultGenesisState returns the default genesis state, which has no
+// articles until governance registers them.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{NextAmendmentId: 1}
+}
+
+// Validate performs a basic validation of the genesis state: the versions must
+// follow each other from 1 up, and the heights must not decrease. Amendment
+// ids must increase, stay below the next id, and acknowledged amendments must
+// refer to versions of the history.
+func (gs GenesisState) Validate() error {
+	for i, articles := range gs.History {
+		if articles.Version != uint64(i+1) {
+			return errors.Wrapf(ErrInvalidVersion, "version %d at position %d of the history", articles.Version, i)
+		}
+		if err := articles.Validate(); err != nil {
+			return errors.Wrapf(err, "version %d", articles.Version)
+		}
+		if i > 0 && articles.Height < gs.History[i-1].Height {
+			return errors.Wrapf(ErrInvalidVersion, "version %d registered at height %d, before version %d", articles.Version, articles.Height, i)
+		}
+	}
+
+	if gs.NextAmendmentId == 0 {
+		return errors.Wrap(ErrInvalidAmendment, "amendment ids start at 1")
+	}
+	for i, amendment := range gs.Amendments {
+		if amendment.Id == 0 || (i > 0 && amendment.Id <= gs.Amendments[i-1].Id) {
+			return errors.Wrapf(ErrInvalidAmendment, "amendment %d at position %d is out of order", amendment.Id, i)
+		}
+		if amendment.Id >= gs.NextAmendmentId {
+			return errors.Wrapf(ErrInvalidAmendment, "amendment %d is not below the next amendment id %d", amendment.Id, gs.NextAmendmentId)
+		}
+		if amendment.ArticlesVersion > uint64(len(gs.History)) {
+			return errors.Wrapf(ErrInvalidAmendment, "amendment %d acknowledged by unknown articles version %d", amendment.Id, amendment.ArticlesVersion)
+		}
+		if err := amendment.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/unicorn-research/chain/x/articles/types"
+)
+
+const documentHash = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+func TestGenesisStateValidate(t *testing.T) {
+	t.Parallel()
+
+	articles := func(version uint64, height int64) types.Articles {
+		return types.Articles{
+			Version:      version,
+			DocumentHash: documentHash,
+			LlcName:      "Unicorn DAO LLC",
+			Identifiers: []types.Identifier{
+				{Type: types.IdentifierTypeModuleAccount, Name: "gov", Value: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"},
+				{Type: types.IdentifierTypeMessageType, Value: "/cosmos.bank.v1beta1.MsgSend"},
+			},
+			Height: height,
+			Time:   time.Unix(0, 0).UTC(),
+		}
+	}
+
+	amendment := func(id, articlesVersion uint64) types.Amendment {
+		return types.Amendment{
+			Id:              id,
+			UpgradeName:     "v11",
+			FromVersions:    []types.ModuleVersion{{Name: "bank", Version: 4}},
+			ToVersions:      []types.ModuleVersion{{Name: "articles", Version: 1}, {Name: "bank", Version: 4}},
+			ArticlesVersion: articlesVersion,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		genState types.GenesisState
+		expErr   error
+	}{
+		{
+			name:     "default",
+			genState: *types.DefaultGenesisState(),
+		},
+		{
+			name:     "history",
+			genState: types.GenesisState{History: []types.Articles{articles(1, 5), articles(2, 10)}, NextAmendmentId: 1},
+		},
+		{
+			name:     "history not starting at 1",
+			genState: types.GenesisState{History: []types.Articles{articles(2, 5)}, NextAmendmentId: 1},
+			expErr:   types.ErrInvalidVersion,
+		},
+		{
+			name:     "missing version",
+			genState: types.GenesisState{History: []types.Articles{articles(1, 5), articles(3, 10)}, NextAmendmentId: 1},
+			expErr:   types.ErrInvalidVersion,
+		},
+		{
+			name:     "decreasing height",
+			genState: types.GenesisState{History: []types.Articles{articles(1, 10), articles(2, 5)}, NextAmendmentId: 1},
+			expErr:   types.ErrInvalidVersion,
+		},
+		{
+			name: "amendments",
+			genState: types.GenesisState{
+				History:         []types.Articles{articles(1, 5)},
+				Amendments:      []types.Amendment{amendment(1, 1), amendment(2, 0)},
+				NextAmendmentId: 3,
+			},
+		},
+		{
+			name:     "no next amendment id",
+			genState: types.GenesisState{},
+			expErr:   types.ErrInvalidAmendment,
+		},
+		{
+			name: "amendment at next id",
+			genState: types.GenesisState{
+				Amendments:      []types.Amendment{amendment(1, 0)},
+				NextAmendmentId: 1,
+			},
+			expErr: types.ErrInvalidAmendment,
+		},
+		{
+			name: "amendments out of order",
+			genState: types.GenesisState{
+				Amendments:      []types.Amendment{amendment(2, 0), amendment(1, 0)},
+				NextAmendmentId: 3,
+			},
+			expErr: types.ErrInvalidAmendment,
+		},
+		{
+			name: "amendment acknowledged by unknown version",
+			genState: types.GenesisState{
+				History:         []types.Articles{articles(1, 5)},
+				Amendments:      []types.Amendment{amendment(1, 2)},
+				NextAmendmentId: 2,
+			},
+			expErr: types.ErrInvalidAmendment,
+		},
+		{
+			name: "invalid document hash",
+			genState: types.GenesisState{NextAmendmentId: 1, History: []types.Articles{func() types.Articles {
+				a := articles(1, 5)
+				a.DocumentHash = "abc"
+				return a
+			}()}},
+			expErr: types.ErrInvalidDocumentHash,
+		},
+		{
+			name: "empty LLC name",
+			genState: types.GenesisState{NextAmendmentId: 1, History: []types.Articles{func() types.Articles {
+				a := articles(1, 5)
+				a.LlcName = " "
+				return a
+			}()}},
+			expErr: types.ErrInvalidLLCName,
+		},
+		{
+			name: "duplicate identifier",
+			genState: types.GenesisState{NextAmendmentId: 1, History: []types.Articles{func() types.Articles {
+				a := articles(1, 5)
+				a.Identifiers = append(a.Identifiers, a.Identifiers[1])
+				return a
+			}()}},
+			expErr: types.ErrInvalidIdentifier,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.genState.Validate()
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDiffIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	gov := types.Identifier{Type: types.IdentifierTypeModuleAccount, Name: "gov", Value: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"}
+	send := types.Identifier{Type: types.IdentifierTypeMessageType, Value: "/cosmos.bank.v1beta1.MsgSend"}
+	vote := types.Identifier{Type: types.IdentifierTypeMessageType, Value: "/cosmos.gov.v1.MsgVote"}
+
+	added, removed := types.DiffIdentifiers([]types.Identifier{gov, send}, []types.Identifier{gov, vote})
+	require.Equal(t, []types.Identifier{vote}, added)
+	require.Equal(t, []types.Identifier{send}, removed)
+
+	added, removed = types.DiffIdentifiers([]types.Identifier{gov, send}, []types.Identifier{send, gov})
+	require.Empty(t, added)
+	require.Empty(t, removed)
+}
@@ -0,0 +1,20 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "articles"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+var (
+	// HistoryKey is the prefix of the versions of the articles, keyed by version.
+	HistoryKey = collections.NewPrefix(0)
+	// AmendmentsKey is the prefix of the amendments, keyed by id.
+	AmendmentsKey = collections.NewPrefix(1)
+	// AmendmentIDKey is the key of the next amendment id.
+	AmendmentIDKey = collections.NewPrefix(2)
+)
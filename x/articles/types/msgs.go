@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgUpdateArticles{}
+
+// NewMsgUpdateArticles creates a new MsgUpdateArticles instance.
+func NewMsgUpdateArticles(authority, documentHash, llcName string) *MsgUpdateArticles {
+	return &MsgUpdateArticles{
+		Authority:    authority,
+		DocumentHash: documentHash,
+		LlcName:      llcName,
+	}
+}
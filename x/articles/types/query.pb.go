@@ -0,0 +1,2371 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: unicorn/articles/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	_ "cosmossdk.io/api/amino"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryArticlesRequest is the Query/Articles request type.
+type QueryArticlesRequest struct {
+}
+
+func (m *QueryArticlesRequest) Reset()         { *m = QueryArticlesRequest{} }
+func (m *QueryArticlesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryArticlesRequest) ProtoMessage()    {}
+func (*QueryArticlesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21dd9c87d9d0c117, []int{0}
+}
+func (m *QueryArticlesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArticlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArticlesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArticlesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArticlesRequest.Merge(m, src)
+}
+func (m *QueryArticlesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArticlesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArticlesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArticlesRequest proto.InternalMessageInfo
+
+// QueryArticlesResponse is the Query/Articles response type.
+type QueryArticlesResponse struct {
+	// articles are the articles in force.
+	Articles Articles `protobuf:"bytes,1,opt,name=articles,proto3" json:"articles"`
+}
+
+func (m *QueryArticlesResponse) Reset()         { *m = QueryArticlesResponse{} }
+func (m *QueryArticlesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryArticlesResponse) ProtoMessage()    {}
+func (*QueryArticlesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21dd9c87d9d0c117, []int{1}
+}
+func (m *QueryArticlesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArticlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArticlesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArticlesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArticlesResponse.Merge(m, src)
+}
+func (m *QueryArticlesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArticlesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArticlesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArticlesResponse proto.InternalMessageInfo
+
+func (m *QueryArticlesResponse) GetArticles() Articles {
+	if m != nil {
+		return m.Articles
+	}
+	return Articles{}
+}
+
+// QueryArticlesVersionRequest is the Query/ArticlesVersion request type.
+type QueryArticlesVersionRequest struct {
+	// version is the version of the articles to return.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *QueryArticlesVersionRequest) Reset()         { *m = QueryArticlesVersionRequest{} }
+func (m *QueryArticlesVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryArticlesVersionRequest) ProtoMessage()    {}
+func (*QueryArticlesVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21dd9c87d9d0c117, []int{2}
+}
+func (m *QueryArticlesVersionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArticlesVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArticlesVersionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArticlesVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArticlesVersionRequest.Merge(m, src)
+}
+func (m *QueryArticlesVersionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArticlesVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArticlesVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArticlesVersionRequest proto.InternalMessageInfo
+
+func (m *QueryArticlesVersionRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// QueryArticlesVersionResponse is the Query/ArticlesVersion response type.
+type QueryArticlesVersionResponse struct {
+	// articles are the requested version of the articles.
+	Articles Articles `protobuf:"bytes,1,opt,name=articles,proto3" json:"articles"`
+}
+
+func (m *QueryArticlesVersionResponse) Reset()         { *m = QueryArticlesVersionResponse{} }
+func (m *QueryArticlesVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryArticlesVersionResponse) ProtoMessage()    {}
+func (*QueryArticlesVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21dd9c87d9d0c117, []int{3}
+}
+func (m *QueryArticlesVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArticlesVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArticlesVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArticlesVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArticlesVersionResponse.Merge(m, src)
+}
+func (m *QueryArticlesVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArticlesVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArticlesVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArticlesVersionResponse proto.InternalMessageInfo
+
+func (m *QueryArticlesVersionResponse) GetArticles() Articles {
+	if m != nil {
+		return m.Articles
+	}
+	return Articles{}
+}
+
+// QueryHistoryRequest is the Query/History request type.
+type QueryHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHistoryRequest) Reset()         { *m = QueryHistoryRequest{} }
+func (m *QueryHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoryRequest) ProtoMessage()    {}
+func (*QueryHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21dd9c87d9d0c117, []int{4}
+}
+func (m *QueryHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoryRequest.Merge(m, src)
+}
+func (m *QueryHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryHistoryResponse is the Query/History response type.
+type QueryHistoryResponse struct {
+	// history holds the versions of the articles, oldest first.
+	History []Articles `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHistoryResponse) Reset()         { *m = QueryHistoryResponse{} }
+func (m *QueryHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoryResponse) ProtoMessage()    {}
+func (*QueryHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21dd9c87d9d0c117, []int{5}
+}
+func (m *QueryHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoryResponse.Merge(m, src)
+}
+func (m *QueryHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryHistoryResponse) GetHistory() []Articles {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *QueryHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAmendmentsRequest is the Query/Amendments request type.
+type QueryAmendmentsRequest struct {
+	// pending_only restricts the amendments to the pending ones.
+	PendingOnly bool `protobuf:"varint,1,opt,name=pending_only,json=pendingOnly,proto3" json:"pending_only,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAmendmentsRequest) Reset()         { *m = QueryAmendmentsRequest{} }
+func (m *QueryAmendmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAmendmentsRequest) ProtoMessage()    {}
+func (*QueryAmendmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21dd9c87d9d0c117, []int{6}
+}
+func (m *QueryAmendmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAmendmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAmendmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAmendmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAmendmentsRequest.Merge(m, src)
+}
+func (m *QueryAmendmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAmendmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAmendmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAmendmentsRequest proto.InternalMessageInfo
+
+func (m *QueryAmendmentsRequest) GetPendingOnly() bool {
+	if m != nil {
+		return m.PendingOnly
+	}
+	return false
+}
+
+func (m *QueryAmendmentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAmendmentsResponse is the Query/Amendments response type.
+type QueryAmendmentsResponse struct {
+	// amendments are the amendments, oldest first.
+	Amendments []Amendment `protobuf:"bytes,1,rep,name=amendments,proto3" json:"amendments"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAmendmentsResponse) Reset()         { *m = QueryAmendmentsResponse{} }
+func (m *QueryAmendmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAmendmentsResponse) ProtoMessage()    {}
+func (*QueryAmendmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21dd9c87d9d0c117, []int{7}
+}
+func (m *QueryAmendmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAmendmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAmendmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAmendmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAmendmentsResponse.Merge(m, src)
+}
+func (m *QueryAmendmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAmendmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAmendmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAmendmentsResponse proto.InternalMessageInfo
+
+func (m *QueryAmendmentsResponse) GetAmendments() []Amendment {
+	if m != nil {
+		return m.Amendments
+	}
+	return nil
+}
+
+func (m *QueryAmendmentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIdentifiersRequest is the Query/Identifiers request type.
+type QueryIdentifiersRequest struct {
+}
+
+func (m *QueryIdentifiersRequest) Reset()         { *m = QueryIdentifiersRequest{} }
+func (m *QueryIdentifiersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIdentifiersRequest) ProtoMessage()    {}
+func (*QueryIdentifiersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21dd9c87d9d0c117, []int{8}
+}
+func (m *QueryIdentifiersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIdentifiersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIdentifiersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIdentifiersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIdentifiersRequest.Merge(m, src)
+}
+func (m *QueryIdentifiersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIdentifiersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIdentifiersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIdentifiersRequest proto.InternalMessageInfo
+
+// QueryIdentifiersResponse is the Query/Identifiers response type.
+type QueryIdentifiersResponse struct {
+	// identifiers are the identifiers of the chain's smart contracts.
+	Identifiers []Identifier `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers"`
+	// added are the identifiers the articles in force do not name.
+	Added []Identifier `protobuf:"bytes,2,rep,name=added,proto3" json:"added"`
+	// removed are the identifiers the articles in force name that the chain no
+	// longer has.
+	Removed []Identifier `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed"`
+}
+
+func (m *QueryIdentifiersResponse) Reset()         { *m = QueryIdentifiersResponse{} }
+func (m *QueryIdentifiersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIdentifiersResponse) ProtoMessage()    {}
+func (*QueryIdentifiersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21dd9c87d9d0c117, []int{9}
+}
+func (m *QueryIdentifiersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIdentifiersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIdentifiersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIdentifiersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIdentifiersResponse.Merge(m, src)
+}
+func (m *QueryIdentifiersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIdentifiersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIdentifiersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIdentifiersResponse proto.InternalMessageInfo
+
+func (m *QueryIdentifiersResponse) GetIdentifiers() []Identifier {
+	if m != nil {
+		return m.Identifiers
+	}
+	return nil
+}
+
+func (m *QueryIdentifiersResponse) GetAdded() []Identifier {
+	if m != nil {
+		return m.Added
+	}
+	return nil
+}
+
+func (m *QueryIdentifiersResponse) GetRemoved() []Identifier {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryArticlesRequest)(nil), "unicorn.articles.v1.QueryArticlesRequest")
+	proto.RegisterType((*QueryArticlesResponse)(nil), "unicorn.articles.v1.QueryArticlesResponse")
+	proto.RegisterType((*QueryArticlesVersionRequest)(nil), "unicorn.articles.v1.QueryArticlesVersionRequest")
+	proto.RegisterType((*QueryArticlesVersionResponse)(nil), "unicorn.articles.v1.QueryArticlesVersionResponse")
+	proto.RegisterType((*QueryHistoryRequest)(nil), "unicorn.articles.v1.QueryHistoryRequest")
+	proto.RegisterType((*QueryHistoryResponse)(nil), "unicorn.articles.v1.QueryHistoryResponse")
+	proto.RegisterType((*QueryAmendmentsRequest)(nil), "unicorn.articles.v1.QueryAmendmentsRequest")
+	proto.RegisterType((*QueryAmendmentsResponse)(nil), "unicorn.articles.v1.QueryAmendmentsResponse")
+	proto.RegisterType((*QueryIdentifiersRequest)(nil), "unicorn.articles.v1.QueryIdentifiersRequest")
+	proto.RegisterType((*QueryIdentifiersResponse)(nil), "unicorn.articles.v1.QueryIdentifiersResponse")
+}
+
+func init() { proto.RegisterFile("unicorn/articles/v1/query.proto", fileDescriptor_21dd9c87d9d0c117) }
+
+var fileDescriptor_21dd9c87d9d0c117 = []byte{
+	// 708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0x3b, 0x20, 0x16, 0x5f, 0x4d, 0x8c, 0x03, 0x62, 0x5d, 0x61, 0x0b, 0x1b, 0x95, 0x1f,
+	0xca, 0x8e, 0xc5, 0x83, 0x57, 0x25, 0x04, 0x25, 0x21, 0x51, 0x7b, 0xf0, 0x60, 0x42, 0xcc, 0xb4,
+	0x3b, 0x6e, 0x27, 0x69, 0x67, 0xca, 0xee, 0xb6, 0xb1, 0x31, 0x5e, 0xd4, 0x83, 0x47, 0x12, 0xfd,
+	0x0f, 0x3c, 0xe8, 0xc5, 0xc4, 0x3f, 0x83, 0x23, 0x89, 0x17, 0x4f, 0xc6, 0x80, 0x89, 0x27, 0x13,
+	0xff, 0x04, 0xc3, 0xec, 0x0c, 0xdd, 0xc2, 0x5a, 0x5a, 0xc3, 0x85, 0x0c, 0x6f, 0xdf, 0xf7, 0xbd,
+	0xcf, 0x7b, 0x33, 0xef, 0x15, 0x0a, 0x4d, 0xc1, 0x2b, 0x32, 0x10, 0x84, 0x06, 0x11, 0xaf, 0xd4,
+	0x58, 0x48, 0x5a, 0x45, 0xb2, 0xd9, 0x64, 0x41, 0xdb, 0x6d, 0x04, 0x32, 0x92, 0x78, 0x4c, 0x3b,
+	0xb8, 0xc6, 0xc1, 0x6d, 0x15, 0xad, 0xf3, 0xb4, 0xce, 0x85, 0x24, 0xea, 0x6f, 0xec, 0x67, 0x2d,
+	0x54, 0x64, 0x58, 0x97, 0x21, 0x29, 0xd3, 0x90, 0xc5, 0x01, 0x48, 0xab, 0x58, 0x66, 0x11, 0x2d,
+	0x92, 0x06, 0xf5, 0xb9, 0xa0, 0x11, 0x97, 0x42, 0xfb, 0x8e, 0xfb, 0xd2, 0x97, 0xea, 0x48, 0xf6,
+	0x4f, 0xda, 0x3a, 0xe9, 0x4b, 0xe9, 0xd7, 0x18, 0xa1, 0x0d, 0x4e, 0xa8, 0x10, 0x32, 0x52, 0x92,
+	0x50, 0x7f, 0x75, 0xd2, 0x40, 0xcd, 0x39, 0xf6, 0x71, 0x26, 0x60, 0xfc, 0xd1, 0x7e, 0xe6, 0xbb,
+	0xda, 0x5c, 0x62, 0x9b, 0x4d, 0x16, 0x46, 0xce, 0x06, 0x5c, 0x38, 0x64, 0x0f, 0x1b, 0x52, 0x84,
+	0x0c, 0xaf, 0xc0, 0xa8, 0x09, 0x91, 0x47, 0xd3, 0x68, 0x2e, 0xb7, 0x34, 0xe5, 0xa6, 0xd4, 0xeb,
+	0x1a, 0xe1, 0xf2, 0x99, 0xed, 0xef, 0x85, 0xcc, 0xa7, 0x5f, 0x5f, 0x16, 0x50, 0xe9, 0x40, 0xe9,
+	0xdc, 0x86, 0xcb, 0x5d, 0xe1, 0x1f, 0xb3, 0x20, 0xe4, 0x52, 0xe8, 0xec, 0x38, 0x0f, 0xd9, 0x56,
+	0x6c, 0x51, 0x39, 0x4e, 0x95, 0xcc, 0xbf, 0x8e, 0x07, 0x93, 0xe9, 0xc2, 0x13, 0xc5, 0xdb, 0x80,
+	0x31, 0x95, 0xe5, 0x3e, 0x0f, 0x23, 0x19, 0xb4, 0x0d, 0xd6, 0x2a, 0x40, 0xe7, 0x62, 0x74, 0xf8,
+	0x6b, 0x6e, 0x7c, 0x8b, 0xee, 0xfe, 0x2d, 0xba, 0xf1, 0x33, 0xd0, 0xb7, 0xe8, 0x3e, 0xa4, 0x3e,
+	0xd3, 0xda, 0x52, 0x42, 0xe9, 0x7c, 0x40, 0x30, 0xde, 0x1d, 0x5f, 0xd3, 0x2f, 0x43, 0xb6, 0x1a,
+	0x9b, 0xf2, 0x68, 0x7a, 0x78, 0x20, 0x78, 0x23, 0xc4, 0xf7, 0xba, 0x20, 0x87, 0x14, 0xe4, 0xec,
+	0xb1, 0x90, 0x31, 0x40, 0x17, 0xe5, 0x6b, 0x04, 0x13, 0x71, 0xaf, 0xeb, 0x4c, 0x78, 0x75, 0x26,
+	0x22, 0xf3, 0x3a, 0xf0, 0x0c, 0x9c, 0x6d, 0x30, 0xe1, 0x71, 0xe1, 0x3f, 0x95, 0xa2, 0xd6, 0x56,
+	0xad, 0x18, 0x2d, 0xe5, 0xb4, 0xed, 0x81, 0xa8, 0xb5, 0xf1, 0x6a, 0x0a, 0xc6, 0xff, 0xf4, 0xea,
+	0x33, 0x82, 0x8b, 0x47, 0x28, 0x74, 0xbb, 0xd6, 0x00, 0xe8, 0x81, 0x55, 0x77, 0xcc, 0x4e, 0xef,
+	0x98, 0x71, 0x4b, 0xb6, 0x2c, 0x21, 0x3e, 0xb9, 0xae, 0x5d, 0xd2, 0xb8, 0x6b, 0x1e, 0x13, 0x11,
+	0x7f, 0xc6, 0x59, 0x70, 0x30, 0x53, 0x7f, 0x10, 0xe4, 0x8f, 0x7e, 0xd3, 0xb5, 0xac, 0x43, 0x8e,
+	0x77, 0xcc, 0xba, 0x98, 0x42, 0x6a, 0x31, 0x1d, 0x79, 0xb2, 0x9a, 0xa4, 0x1c, 0xdf, 0x81, 0x11,
+	0xea, 0x79, 0xcc, 0xcb, 0x0f, 0x0d, 0x1c, 0x27, 0x16, 0xe2, 0x15, 0xc8, 0x06, 0xac, 0x2e, 0x5b,
+	0xcc, 0xcb, 0x0f, 0x0f, 0x1c, 0xc3, 0x48, 0x97, 0x7e, 0x8f, 0xc0, 0x88, 0x2a, 0x19, 0xbf, 0x45,
+	0x30, 0x6a, 0xde, 0x2d, 0x9e, 0x4f, 0x8d, 0x95, 0xb6, 0x88, 0xac, 0x85, 0x7e, 0x5c, 0xe3, 0x1e,
+	0x3a, 0x57, 0x5f, 0x7d, 0xfd, 0xf9, 0x6e, 0xa8, 0x80, 0xa7, 0x48, 0xaf, 0xcd, 0x87, 0x3f, 0x22,
+	0x38, 0x77, 0x68, 0x7f, 0xe0, 0x9b, 0xc7, 0xa7, 0xe9, 0xde, 0x51, 0x56, 0x71, 0x00, 0x85, 0xe6,
+	0x23, 0x8a, 0x6f, 0x1e, 0xcf, 0xf6, 0xe4, 0x23, 0x2f, 0xf4, 0xb2, 0x7b, 0x89, 0xdf, 0x20, 0xc8,
+	0xea, 0x1d, 0x81, 0xe7, 0xfe, 0x9d, 0xaf, 0x7b, 0x4d, 0x59, 0xf3, 0x7d, 0x78, 0x6a, 0xa2, 0x2b,
+	0x8a, 0xc8, 0xc6, 0x93, 0xa9, 0x44, 0x66, 0xa5, 0x6c, 0x21, 0x80, 0xce, 0xf8, 0xe1, 0xeb, 0x3d,
+	0x2a, 0x3f, 0xbc, 0x2a, 0xac, 0x1b, 0xfd, 0x39, 0x6b, 0x9e, 0x59, 0xc5, 0x33, 0x83, 0x0b, 0xe9,
+	0x1d, 0xea, 0x30, 0xbc, 0x47, 0x90, 0x4b, 0x8c, 0x11, 0xee, 0x91, 0xe6, 0xe8, 0x24, 0x5a, 0x8b,
+	0x7d, 0x7a, 0x6b, 0xaa, 0x39, 0x45, 0xe5, 0xe0, 0xe9, 0x54, 0xaa, 0xc4, 0xdc, 0x2d, 0xaf, 0x6f,
+	0xef, 0xda, 0x68, 0x67, 0xd7, 0x46, 0x3f, 0x76, 0x6d, 0xb4, 0xb5, 0x67, 0x67, 0x76, 0xf6, 0xec,
+	0xcc, 0xb7, 0x3d, 0x3b, 0xf3, 0x64, 0xc9, 0xe7, 0x51, 0xb5, 0x59, 0x76, 0x2b, 0xb2, 0x6e, 0xa2,
+	0x2c, 0x06, 0x2c, 0x64, 0x34, 0xa8, 0x54, 0x49, 0xa5, 0x4a, 0xb9, 0x20, 0xcf, 0x3b, 0x61, 0xa3,
+	0x76, 0x83, 0x85, 0xe5, 0xd3, 0xea, 0x37, 0xfa, 0xd6, 0xdf, 0x01, 0x00, 0x99, 0x9a, 0xc7, 0x2e,
+	0x72, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Articles returns the articles in force.
+	Articles(ctx context.Context, in *QueryArticlesRequest, opts ...grpc.CallOption) (*QueryArticlesResponse, error)
+	// ArticlesVersion returns a version of the articles.
+	ArticlesVersion(ctx context.Context, in *QueryArticlesVersionRequest, opts ...grpc.CallOption) (*QueryArticlesVersionResponse, error)
+	// History returns every version of the articles, oldest first.
+	History(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*QueryHistoryResponse, error)
+	// Amendments returns the amendments software upgrades required.
+	Amendments(ctx context.Context, in *QueryAmendmentsRequest, opts ...grpc.CallOption) (*QueryAmendmentsResponse, error)
+	// Identifiers returns the identifiers of the chain's smart contracts, and
+	// how they differ from those the articles in force name.
+	Identifiers(ctx context.Context, in *QueryIdentifiersRequest, opts ...grpc.CallOption) (*QueryIdentifiersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Articles(ctx context.Context, in *QueryArticlesRequest, opts ...grpc.CallOption) (*QueryArticlesResponse, error) {
+	out := new(QueryArticlesResponse)
+	err := c.cc.Invoke(ctx, "/unicorn.articles.v1.Query/Articles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ArticlesVersion(ctx context.Context, in *QueryArticlesVersionRequest, opts ...grpc.CallOption) (*QueryArticlesVersionResponse, error) {
+	out := new(QueryArticlesVersionResponse)
+	err := c.cc.Invoke(ctx, "/unicorn.articles.v1.Query/ArticlesVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) History(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*QueryHistoryResponse, error) {
+	out := new(QueryHistoryResponse)
+	err := c.cc.Invoke(ctx, "/unicorn.articles.v1.Query/History", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Amendments(ctx context.Context, in *QueryAmendmentsRequest, opts ...grpc.CallOption) (*QueryAmendmentsResponse, error) {
+	out := new(QueryAmendmentsResponse)
+	err := c.cc.Invoke(ctx, "/unicorn.articles.v1.Query/Amendments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Identifiers(ctx context.Context, in *QueryIdentifiersRequest, opts ...grpc.CallOption) (*QueryIdentifiersResponse, error) {
+	out := new(QueryIdentifiersResponse)
+	err := c.cc.Invoke(ctx, "/unicorn.articles.v1.Query/Identifiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Articles returns the articles in force.
+	Articles(context.Context, *QueryArticlesRequest) (*QueryArticlesResponse, error)
+	// ArticlesVersion returns a version of the articles.
+	ArticlesVersion(context.Context, *QueryArticlesVersionRequest) (*QueryArticlesVersionResponse, error)
+	// History returns every version of the articles, oldest first.
+	History(context.Context, *QueryHistoryRequest) (*QueryHistoryResponse, error)
+	// Amendments returns the amendments software upgrades required.
+	Amendments(context.Context, *QueryAmendmentsRequest) (*QueryAmendmentsResponse, error)
+	// Identifiers returns the identifiers of the chain's smart contracts, and
+	// how they differ from those the articles in force name.
+	Identifiers(context.Context, *QueryIdentifiersRequest) (*QueryIdentifiersResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Articles(ctx context.Context, req *QueryArticlesRequest) (*QueryArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Articles not implemented")
+}
+func (*UnimplementedQueryServer) ArticlesVersion(ctx context.Context, req *QueryArticlesVersionRequest) (*QueryArticlesVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArticlesVersion not implemented")
+}
+func (*UnimplementedQueryServer) History(ctx context.Context, req *QueryHistoryRequest) (*QueryHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
+func (*UnimplementedQueryServer) Amendments(ctx context.Context, req *QueryAmendmentsRequest) (*QueryAmendmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Amendments not implemented")
+}
+func (*UnimplementedQueryServer) Identifiers(ctx context.Context, req *QueryIdentifiersRequest) (*QueryIdentifiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Identifiers not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Articles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Articles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/unicorn.articles.v1.Query/Articles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Articles(ctx, req.(*QueryArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ArticlesVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArticlesVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArticlesVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/unicorn.articles.v1.Query/ArticlesVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArticlesVersion(ctx, req.(*QueryArticlesVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/unicorn.articles.v1.Query/History",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).History(ctx, req.(*QueryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Amendments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAmendmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Amendments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/unicorn.articles.v1.Query/Amendments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Amendments(ctx, req.(*QueryAmendmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Identifiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIdentifiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Identifiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/unicorn.articles.v1.Query/Identifiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Identifiers(ctx, req.(*QueryIdentifiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "unicorn.articles.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Articles",
+			Handler:    _Query_Articles_Handler,
+		},
+		{
+			MethodName: "ArticlesVersion",
+			Handler:    _Query_ArticlesVersion_Handler,
+		},
+		{
+			MethodName: "History",
+			Handler:    _Query_History_Handler,
+		},
+		{
+			MethodName: "Amendments",
+			Handler:    _Query_Amendments_Handler,
+		},
+		{
+			MethodName: "Identifiers",
+			Handler:    _Query_Identifiers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "unicorn/articles/v1/query.proto",
+}
+
+func (m *QueryArticlesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArticlesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArticlesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryArticlesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArticlesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArticlesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Articles.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryArticlesVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArticlesVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArticlesVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryArticlesVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArticlesVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArticlesVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Articles.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAmendmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAmendmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAmendmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PendingOnly {
+		i--
+		if m.PendingOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAmendmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAmendmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAmendmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amendments) > 0 {
+		for iNdEx := len(m.Amendments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amendments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIdentifiersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIdentifiersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIdentifiersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIdentifiersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIdentifiersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIdentifiersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Removed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Added) > 0 {
+		for iNdEx := len(m.Added) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Added[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Identifiers) > 0 {
+		for iNdEx := len(m.Identifiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Identifiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryArticlesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryArticlesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Articles.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryArticlesVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryArticlesVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Articles.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAmendmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingOnly {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAmendmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amendments) > 0 {
+		for _, e := range m.Amendments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIdentifiersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIdentifiersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Identifiers) > 0 {
+		for _, e := range m.Identifiers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Added) > 0 {
+		for _, e := range m.Added {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, e := range m.Removed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryArticlesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryArticlesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryArticlesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryArticlesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryArticlesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryArticlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Articles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Articles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryArticlesVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryArticlesVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryArticlesVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryArticlesVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryArticlesVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryArticlesVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Articles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Articles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, Articles{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAmendmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAmendmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAmendmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PendingOnly = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAmendmentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAmendmentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAmendmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amendments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amendments = append(m.Amendments, Amendment{})
+			if err := m.Amendments[len(m.Amendments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIdentifiersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIdentifiersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIdentifiersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIdentifiersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIdentifiersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIdentifiersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifiers = append(m.Identifiers, Identifier{})
+			if err := m.Identifiers[len(m.Identifiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Added = append(m.Added, Identifier{})
+			if err := m.Added[len(m.Added)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, Identifier{})
+			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
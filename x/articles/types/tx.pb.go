@@ -0,0 +1,803 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: unicorn/articles/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	_ "cosmossdk.io/api/amino"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateArticles is the Msg/UpdateArticles request type.
+//
+// The identifiers of the new version are those of the chain when the message
+// is executed, so the articles always name the contracts actually running.
+// While amendments are pending the document hash must differ from that of the
+// articles in force.
+type MsgUpdateArticles struct {
+	// authority is the address that controls the module, which defaults to the
+	// x/gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// document_hash is the hex encoded SHA-256 hash of the amended articles
+	// document.
+	DocumentHash string `protobuf:"bytes,2,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty"`
+	// llc_name is the name the DAO LLC is registered under.
+	LlcName string `protobuf:"bytes,3,opt,name=llc_name,json=llcName,proto3" json:"llc_name,omitempty"`
+}
+
+func (m *MsgUpdateArticles) Reset()         { *m = MsgUpdateArticles{} }
+func (m *MsgUpdateArticles) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateArticles) ProtoMessage()    {}
+func (*MsgUpdateArticles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_079a2c3c7459cf34, []int{0}
+}
+func (m *MsgUpdateArticles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateArticles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateArticles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateArticles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateArticles.Merge(m, src)
+}
+func (m *MsgUpdateArticles) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateArticles) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateArticles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateArticles proto.InternalMessageInfo
+
+func (m *MsgUpdateArticles) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateArticles) GetDocumentHash() string {
+	if m != nil {
+		return m.DocumentHash
+	}
+	return ""
+}
+
+func (m *MsgUpdateArticles) GetLlcName() string {
+	if m != nil {
+		return m.LlcName
+	}
+	return ""
+}
+
+// MsgUpdateArticlesResponse is the Msg/UpdateArticles response type.
+type MsgUpdateArticlesResponse struct {
+	// version is the version of the registered articles.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// acknowledged_amendments are the ids of the pending amendments the new
+	// articles acknowledged.
+	AcknowledgedAmendments []uint64 `protobuf:"varint,2,rep,packed,name=acknowledged_amendments,json=acknowledgedAmendments,proto3" json:"acknowledged_amendments,omitempty"`
+}
+
+func (m *MsgUpdateArticlesResponse) Reset()         { *m = MsgUpdateArticlesResponse{} }
+func (m *MsgUpdateArticlesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateArticlesResponse) ProtoMessage()    {}
+func (*MsgUpdateArticlesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_079a2c3c7459cf34, []int{1}
+}
+func (m *MsgUpdateArticlesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateArticlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateArticlesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateArticlesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateArticlesResponse.Merge(m, src)
+}
+func (m *MsgUpdateArticlesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateArticlesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateArticlesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateArticlesResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateArticlesResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *MsgUpdateArticlesResponse) GetAcknowledgedAmendments() []uint64 {
+	if m != nil {
+		return m.AcknowledgedAmendments
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgUpdateArticles)(nil), "unicorn.articles.v1.MsgUpdateArticles")
+	proto.RegisterType((*MsgUpdateArticlesResponse)(nil), "unicorn.articles.v1.MsgUpdateArticlesResponse")
+}
+
+func init() { proto.RegisterFile("unicorn/articles/v1/tx.proto", fileDescriptor_079a2c3c7459cf34) }
+
+var fileDescriptor_079a2c3c7459cf34 = []byte{
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x8e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0xcb, 0xc1, 0x71, 0x2b, 0x40, 0x3a, 0x83, 0x38, 0xc7, 0x42, 0xd6, 0xe9, 0x40,
+	0xe8, 0x14, 0xe9, 0xbc, 0xba, 0x20, 0x11, 0x89, 0x2e, 0xa9, 0x28, 0x08, 0x85, 0x11, 0x0d, 0x8d,
+	0xb5, 0x59, 0xaf, 0xbc, 0x2b, 0xbc, 0xbb, 0xd6, 0xce, 0xda, 0x24, 0x1d, 0xa2, 0xa4, 0xe2, 0x51,
+	0x52, 0xf0, 0x08, 0x14, 0x94, 0x11, 0x15, 0x25, 0x4a, 0x8a, 0xbc, 0x06, 0x8a, 0xff, 0x10, 0x20,
+	0x14, 0xd7, 0x58, 0x9a, 0xf9, 0xcd, 0x7c, 0xfa, 0xe6, 0xf3, 0xa2, 0x87, 0x85, 0x12, 0x54, 0x1b,
+	0x85, 0x89, 0xb1, 0x82, 0x66, 0x0c, 0x70, 0x79, 0x85, 0xed, 0x2c, 0xcc, 0x8d, 0xb6, 0xda, 0xbd,
+	0xd7, 0xd0, 0xb0, 0xa5, 0x61, 0x79, 0xe5, 0x9f, 0x10, 0x29, 0x94, 0xc6, 0xd5, 0xb7, 0x9e, 0xf3,
+	0x4f, 0xa9, 0x06, 0xa9, 0x01, 0x4b, 0x48, 0xb7, 0xfb, 0x12, 0xd2, 0x06, 0xf4, 0x6a, 0x10, 0x57,
+	0x15, 0xae, 0x8b, 0x1a, 0x9d, 0x7f, 0x75, 0xd0, 0xc9, 0x04, 0xd2, 0x37, 0x79, 0x42, 0x2c, 0x1b,
+	0x35, 0xfa, 0xee, 0x33, 0x74, 0x4c, 0x0a, 0xcb, 0xb5, 0x11, 0x76, 0xee, 0x39, 0x67, 0xce, 0xc5,
+	0xf1, 0xd8, 0xfb, 0xfe, 0xe5, 0xf2, 0x7e, 0xb3, 0x3a, 0x4a, 0x12, 0xc3, 0x00, 0x5e, 0x5b, 0x23,
+	0x54, 0x1a, 0xed, 0x46, 0xdd, 0x47, 0xe8, 0x4e, 0xa2, 0x69, 0x21, 0x99, 0xb2, 0x31, 0x27, 0xc0,
+	0xbd, 0x83, 0xed, 0x6e, 0x74, 0xbb, 0x6d, 0xbe, 0x20, 0xc0, 0xdd, 0x1e, 0xba, 0x95, 0x65, 0x34,
+	0x56, 0x44, 0x32, 0xaf, 0x5b, 0xf1, 0xa3, 0x2c, 0xa3, 0xaf, 0x88, 0x64, 0xcf, 0x87, 0x1f, 0x37,
+	0x8b, 0xfe, 0x4e, 0xef, 0xd3, 0x66, 0xd1, 0x7f, 0xdc, 0x46, 0x33, 0xdb, 0x85, 0xb3, 0x67, 0xf8,
+	0x5c, 0xa1, 0xde, 0x5e, 0x33, 0x62, 0x90, 0x6b, 0x05, 0xcc, 0xf5, 0xd0, 0x51, 0xc9, 0x0c, 0x08,
+	0xad, 0xaa, 0x5b, 0x0e, 0xa3, 0xb6, 0x74, 0x87, 0xe8, 0x94, 0xd0, 0x77, 0x4a, 0xbf, 0xcf, 0x58,
+	0x92, 0xb2, 0x24, 0x26, 0x92, 0xa9, 0x64, 0x6b, 0x14, 0xbc, 0x83, 0xb3, 0xee, 0xc5, 0x61, 0xf4,
+	0xe0, 0x4f, 0x3c, 0xfa, 0x4d, 0x07, 0x25, 0xea, 0x4e, 0x20, 0x75, 0x39, 0xba, 0xfb, 0x4f, 0x72,
+	0x4f, 0xc2, 0xff, 0xfc, 0xac, 0x70, 0xcf, 0x9b, 0x1f, 0x5e, 0x6f, 0xae, 0xbd, 0xc1, 0xbf, 0xf1,
+	0x61, 0xb3, 0xe8, 0x3b, 0xe3, 0x97, 0xdf, 0x56, 0x81, 0xb3, 0x5c, 0x05, 0xce, 0xcf, 0x55, 0xe0,
+	0x7c, 0x5e, 0x07, 0x9d, 0xe5, 0x3a, 0xe8, 0xfc, 0x58, 0x07, 0x9d, 0xb7, 0x83, 0x54, 0x58, 0x5e,
+	0x4c, 0x43, 0xaa, 0x25, 0x6e, 0xa4, 0x2f, 0x0d, 0x03, 0x46, 0x0c, 0xe5, 0x98, 0x72, 0x22, 0xfe,
+	0x4a, 0xd0, 0xce, 0x73, 0x06, 0xd3, 0x9b, 0xd5, 0x1b, 0x78, 0xfa, 0x6b, 0x00, 0xdb, 0x83, 0x1c,
+	0x1c, 0x7f, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateArticles registers a new version of the articles, acknowledging the
+	// pending amendments. It can only be executed through a governance proposal.
+	UpdateArticles(ctx context.Context, in *MsgUpdateArticles, opts ...grpc.CallOption) (*MsgUpdateArticlesResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateArticles(ctx context.Context, in *MsgUpdateArticles, opts ...grpc.CallOption) (*MsgUpdateArticlesResponse, error) {
+	out := new(MsgUpdateArticlesResponse)
+	err := c.cc.Invoke(ctx, "/unicorn.articles.v1.Msg/UpdateArticles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateArticles registers a new version of the articles, acknowledging the
+	// pending amendments. It can only be executed through a governance proposal.
+	UpdateArticles(context.Context, *MsgUpdateArticles) (*MsgUpdateArticlesResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateArticles(ctx context.Context, req *MsgUpdateArticles) (*MsgUpdateArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateArticles not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateArticles)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/unicorn.articles.v1.Msg/UpdateArticles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateArticles(ctx, req.(*MsgUpdateArticles))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "unicorn.articles.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateArticles",
+			Handler:    _Msg_UpdateArticles_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "unicorn/articles/v1/tx.proto",
+}
+
+func (m *MsgUpdateArticles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateArticles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateArticles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LlcName) > 0 {
+		i -= len(m.LlcName)
+		copy(dAtA[i:], m.LlcName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LlcName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DocumentHash) > 0 {
+		i -= len(m.DocumentHash)
+		copy(dAtA[i:], m.DocumentHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DocumentHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateArticlesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateArticlesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateArticlesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AcknowledgedAmendments) > 0 {
+		dAtA2 := make([]byte, len(m.AcknowledgedAmendments)*10)
+		var j1 int
+		for _, num := range m.AcknowledgedAmendments {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateArticles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DocumentHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LlcName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateArticlesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	if len(m.AcknowledgedAmendments) > 0 {
+		l = 0
+		for _, e := range m.AcknowledgedAmendments {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateArticles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateArticles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateArticles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LlcName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LlcName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateArticlesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateArticlesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateArticlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AcknowledgedAmendments = append(m.AcknowledgedAmendments, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AcknowledgedAmendments) == 0 {
+					m.AcknowledgedAmendments = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AcknowledgedAmendments = append(m.AcknowledgedAmendments, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedAmendments", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
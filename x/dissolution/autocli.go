@@ -0,0 +1,50 @@
+package dissolution
+
+import (
+	"github.com/unicorn-research/chain/x/dissolution/types"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the dissolution parameters",
+				},
+				{
+					RpcMethod: "Watchdog",
+					Use:       "watchdog",
+					Short:     "Query the last passed proposal, the dissolution deadline and the days remaining until it",
+				},
+				{
+					RpcMethod: "Dissolution",
+					Use:       "dissolution",
+					Short:     "Query the dissolution of the DAO by member vote, once it dissolved",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "Dissolve",
+					Use:            "dissolve [halt-height]",
+					Short:          "Submit a proposal to dissolve the DAO, distributing the community pool to the token holders and halting the chain at halt-height",
+					Example:        "dissolve 1500000",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "halt_height"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,289 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	stdmath "math"
+
+	"github.com/unicorn-research/chain/x/dissolution/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// Dissolve dissolves the DAO by member vote. It winds the chain down: new
+// delegations, sends between accounts and changes to the halt are disabled
+// through the circuit breaker, and an x/upgrade plan halts the chain at the
+// halt height. The community pool is then distributed to the token holders
+// over the following blocks, so the halt height has to leave enough blocks
+// to tally and pay every account, DistributionBatchSize a block each.
+func (k Keeper) Dissolve(ctx context.Context, haltHeight int64) (types.Dissolution, error) {
+	dissolved, err := k.Dissolution.Has(ctx)
+	if err != nil {
+		return types.Dissolution{}, err
+	}
+	if dissolved {
+		return types.Dissolution{}, types.ErrAlreadyDissolved
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if haltHeight <= sdkCtx.BlockHeight() {
+		return types.Dissolution{}, errorsmod.Wrapf(types.ErrInvalidDissolution, "halt height %d is not after the current height %d", haltHeight, sdkCtx.BlockHeight())
+	}
+
+	plan := upgradetypes.Plan{
+		Name:   types.HaltUpgradeName,
+		Height: haltHeight,
+		Info:   "The DAO dissolved by member vote, the chain halts at this height.",
+	}
+	if err := k.upgradeKeeper.ScheduleUpgrade(ctx, plan); err != nil {
+		return types.Dissolution{}, err
+	}
+
+	for _, msgType := range types.WindDownMsgTypes() {
+		if err := k.disableList.Set(ctx, msgType); err != nil {
+			return types.Dissolution{}, err
+		}
+	}
+
+	dissolution := types.Dissolution{
+		Height:      sdkCtx.BlockHeight(),
+		Time:        sdkCtx.BlockTime(),
+		HaltHeight:  haltHeight,
+		Distributed: sdk.NewCoins(),
+	}
+	if err := dissolution.Validate(); err != nil {
+		return types.Dissolution{}, err
+	}
+
+	if err := k.Dissolution.Set(ctx, dissolution); err != nil {
+		return types.Dissolution{}, err
+	}
+	if err := k.DistributionProgress.Set(ctx, types.DistributionProgress{TotalHoldings: math.ZeroInt()}); err != nil {
+		return types.Dissolution{}, err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventDissolved{
+		HaltHeight: dissolution.HaltHeight,
+	}); err != nil {
+		return types.Dissolution{}, err
+	}
+
+	return dissolution, nil
+}
+
+// DistributeCommunityPool advances the distribution of the community pool to
+// the holders of the bond denom, pro rata to their balances and delegations.
+// It first tallies the holdings of DistributionBatchSize accounts a block, then
+// snapshots the pool and pays as many holders a block. What rounding leaves
+// stays in the pool. Without a distribution in progress it does nothing.
+func (k Keeper) DistributeCommunityPool(ctx context.Context) error {
+	progress, err := k.DistributionProgress.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if progress.Paying {
+		return k.payHolders(ctx, progress)
+	}
+
+	return k.tallyHoldings(ctx, progress)
+}
+
+// tallyHoldings records the holdings of the next accounts after the cursor.
+// Module accounts hold none. Once every account is tallied, the community pool
+// to pay is snapshot.
+func (k Keeper) tallyHoldings(ctx context.Context, progress types.DistributionProgress) error {
+	ranger := new(collections.Range[sdk.AccAddress])
+	if progress.Cursor != "" {
+		cursor, err := k.ak.AddressCodec().StringToBytes(progress.Cursor)
+		if err != nil {
+			return err
+		}
+		ranger = ranger.StartExclusive(cursor)
+	}
+
+	accounts, err := k.nextAccounts(ctx, ranger)
+	if err != nil {
+		return err
+	}
+
+	if len(accounts) == 0 {
+		feePool, err := k.feePool.Get(ctx)
+		if err != nil {
+			return err
+		}
+		progress.Pool, _ = feePool.CommunityPool.TruncateDecimal()
+		progress.Paying = true
+
+		return k.DistributionProgress.Set(ctx, progress)
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, account := range accounts {
+		if _, ok := account.(sdk.ModuleAccountI); ok {
+			continue
+		}
+
+		holding, err := k.holding(ctx, account.GetAddress(), bondDenom)
+		if err != nil {
+			return err
+		}
+		if !holding.IsPositive() {
+			continue
+		}
+
+		if err := k.Holdings.Set(ctx, account.GetAddress(), holding); err != nil {
+			return err
+		}
+		progress.TotalHoldings = progress.TotalHoldings.Add(holding)
+	}
+
+	progress.Cursor, err = k.ak.AddressCodec().BytesToString(accounts[len(accounts)-1].GetAddress())
+	if err != nil {
+		return err
+	}
+
+	return k.DistributionProgress.Set(ctx, progress)
+}
+
+// nextAccounts returns up to DistributionBatchSize accounts in the range.
+func (k Keeper) nextAccounts(ctx context.Context, ranger collections.Ranger[sdk.AccAddress]) ([]sdk.AccountI, error) {
+	iter, err := k.accounts.Iterate(ctx, ranger)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var accounts []sdk.AccountI
+	for ; iter.Valid() && len(accounts) < types.DistributionBatchSize; iter.Next() {
+		account, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
+// holding returns the amount of the bond denom the account holds, in its
+// balance or delegated.
+func (k Keeper) holding(ctx context.Context, address sdk.AccAddress, bondDenom string) (math.Int, error) {
+	holding := k.bankKeeper.GetBalance(ctx, address, bondDenom).Amount
+
+	delegations, err := k.stakingKeeper.GetDelegatorDelegations(ctx, address, stdmath.MaxUint16)
+	if err != nil {
+		return math.Int{}, err
+	}
+	for _, delegation := range delegations {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			return math.Int{}, err
+		}
+		validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return math.Int{}, fmt.Errorf("validator of the delegation of %s: %w", delegation.DelegatorAddress, err)
+		}
+
+		holding = holding.Add(validator.TokensFromShares(delegation.Shares).TruncateInt())
+	}
+
+	return holding, nil
+}
+
+// payHolders pays the next holders their share of the snapshot pool, adding
+// it to the dissolution's distribution. Once every holder is paid, the
+// distribution ends.
+//
+// Shares are capped at what is left in the community pool, so a pool spent
+// below the snapshot, which would otherwise fail the payment and halt the
+// chain, pays what it still holds and the holders paid last get less.
+func (k Keeper) payHolders(ctx context.Context, progress types.DistributionProgress) error {
+	dissolution, err := k.Dissolution.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	holdings, err := k.nextHoldings(ctx)
+	if err != nil {
+		return err
+	}
+
+	feePool, err := k.feePool.Get(ctx)
+	if err != nil {
+		return err
+	}
+	available, _ := feePool.CommunityPool.TruncateDecimal()
+
+	for _, holding := range holdings {
+		var share sdk.Coins
+		for _, coin := range progress.Pool {
+			amount := coin.Amount.Mul(holding.Value).Quo(progress.TotalHoldings)
+			if amount = math.MinInt(amount, available.AmountOf(coin.Denom)); amount.IsPositive() {
+				share = share.Add(sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+
+		if !share.IsZero() {
+			if err := k.distrKeeper.DistributeFromFeePool(ctx, share, holding.Key); err != nil {
+				return err
+			}
+			available = available.Sub(share...)
+			dissolution.Distributed = dissolution.Distributed.Add(share...)
+			dissolution.Recipients++
+		}
+
+		if err := k.Holdings.Remove(ctx, holding.Key); err != nil {
+			return err
+		}
+	}
+
+	if err := k.Dissolution.Set(ctx, dissolution); err != nil {
+		return err
+	}
+	if len(holdings) > 0 {
+		return nil
+	}
+
+	if err := k.DistributionProgress.Remove(ctx); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventCommunityPoolDistributed{
+		Distributed: dissolution.Distributed,
+		Recipients:  dissolution.Recipients,
+	})
+}
+
+// nextHoldings returns up to DistributionBatchSize holdings not yet paid.
+func (k Keeper) nextHoldings(ctx context.Context) ([]collections.KeyValue[sdk.AccAddress, math.Int], error) {
+	iter, err := k.Holdings.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var holdings []collections.KeyValue[sdk.AccAddress, math.Int]
+	for ; iter.Valid() && len(holdings) < types.DistributionBatchSize; iter.Next() {
+		holding, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		holdings = append(holdings, holding)
+	}
+
+	return holdings, nil
+}
@@ -0,0 +1,219 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/unicorn-research/chain/x/dissolution/keeper"
+	"github.com/unicorn-research/chain/x/dissolution/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestDissolve(t *testing.T) {
+	t.Parallel()
+
+	f := setupFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+
+	holderA := sdk.AccAddress("holder_a____________")
+	holderB := sdk.AccAddress("holder_b____________")
+	holderC := sdk.AccAddress("holder_c____________")
+	validator := sdk.ValAddress("validator___________")
+	validatorAddress, err := f.stakingKeeper.ValidatorAddressCodec().BytesToString(validator)
+	require.NoError(t, err)
+
+	// B delegates half its holdings, the module accounts' balances are not
+	// holdings and C holds no bond denom
+	for _, holder := range []sdk.AccAddress{holderA, holderB, holderC} {
+		require.NoError(t, f.accounts.Set(f.ctx, holder, authtypes.NewBaseAccountWithAddress(holder)))
+	}
+	distrAccount := authtypes.NewEmptyModuleAccount(distrtypes.ModuleName)
+	require.NoError(t, f.accounts.Set(f.ctx, distrAccount.GetAddress(), distrAccount))
+	f.bankKeeper.balances[string(holderA)] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+	f.bankKeeper.balances[string(holderB)] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	f.bankKeeper.balances[string(holderC)] = sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+	f.bankKeeper.balances[string(distrAccount.GetAddress())] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	f.stakingKeeper.validators[string(validator)] = stakingtypes.Validator{
+		OperatorAddress: validatorAddress,
+		Tokens:          math.NewInt(200),
+		DelegatorShares: math.LegacyNewDec(100),
+	}
+	f.stakingKeeper.delegations = []stakingtypes.Delegation{
+		stakingtypes.NewDelegation(holderB.String(), validatorAddress, math.LegacyNewDec(50)),
+	}
+	f.distrKeeper.feePool.CommunityPool = sdk.NewDecCoins(
+		sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 1000),
+		sdk.NewDecCoinFromDec("uatom", math.LegacyNewDecWithPrec(105, 1)),
+	)
+
+	_, err = queryServer.Dissolution(f.ctx, &types.QueryDissolutionRequest{})
+	require.ErrorIs(t, err, types.ErrNotDissolved)
+
+	// Without a dissolution there is nothing to distribute
+	require.NoError(t, f.keeper.DistributeCommunityPool(f.ctx))
+	require.Empty(t, f.distrKeeper.paid)
+
+	// Only the authority can dissolve the DAO
+	_, err = msgServer.Dissolve(f.ctx, types.NewMsgDissolve("cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysn3k7pr0", 100))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.Dissolve(f.ctx, types.NewMsgDissolve(f.authority, f.ctx.BlockHeight()))
+	require.ErrorIs(t, err, types.ErrInvalidDissolution)
+
+	ctx := f.at(f.start)
+	_, err = msgServer.Dissolve(ctx, types.NewMsgDissolve(f.authority, 100))
+	require.NoError(t, err)
+	require.Equal(t, []string{"unicorn.dissolution.v1.EventDissolved"}, eventTypes(ctx))
+	require.Empty(t, f.distrKeeper.paid)
+
+	// The wind down is disabled through the circuit breaker and the chain
+	// halts at the halt height
+	for _, msgType := range types.WindDownMsgTypes() {
+		require.True(t, f.disableList[msgType], msgType)
+	}
+	require.NotNil(t, f.upgradeKeeper.plan)
+	require.Equal(t, types.HaltUpgradeName, f.upgradeKeeper.plan.Name)
+	require.Equal(t, int64(100), f.upgradeKeeper.plan.Height)
+
+	_, err = msgServer.Dissolve(ctx, types.NewMsgDissolve(f.authority, 200))
+	require.ErrorIs(t, err, types.ErrAlreadyDissolved)
+
+	// The first block tallies the holdings, A 300 and B 200
+	require.NoError(t, f.keeper.DistributeCommunityPool(ctx))
+	res, err := queryServer.Dissolution(ctx, &types.QueryDissolutionRequest{})
+	require.NoError(t, err)
+	require.NotNil(t, res.DistributionProgress)
+	require.False(t, res.DistributionProgress.Paying)
+	require.Equal(t, math.NewInt(500), res.DistributionProgress.TotalHoldings)
+
+	// The distribution in progress is exported
+	genState, err := f.keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, res.DistributionProgress, genState.DistributionProgress)
+	require.Equal(t, []types.Holding{
+		{Address: holderA.String(), Amount: math.NewInt(300)},
+		{Address: holderB.String(), Amount: math.NewInt(200)},
+	}, genState.Holdings)
+	require.NoError(t, genState.Validate())
+
+	// The next one snapshots the pool once every account is tallied
+	require.NoError(t, f.keeper.DistributeCommunityPool(ctx))
+	progress, err := f.keeper.DistributionProgress.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, progress.Paying)
+	require.Equal(t, "1000stake,10uatom", progress.Pool.String())
+
+	// Then the holders are paid, and what rounding leaves stays in the pool
+	require.NoError(t, f.keeper.DistributeCommunityPool(ctx))
+	require.Equal(t, map[string]sdk.Coins{
+		holderA.String(): sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600), sdk.NewInt64Coin("uatom", 6)),
+		holderB.String(): sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400), sdk.NewInt64Coin("uatom", 4)),
+	}, f.distrKeeper.paid)
+	require.Equal(t, "0.500000000000000000uatom", f.distrKeeper.feePool.CommunityPool.String())
+
+	ctx = f.at(f.start)
+	require.NoError(t, f.keeper.DistributeCommunityPool(ctx))
+	require.Equal(t, []string{"unicorn.dissolution.v1.EventCommunityPoolDistributed"}, eventTypes(ctx))
+
+	res, err = queryServer.Dissolution(ctx, &types.QueryDissolutionRequest{})
+	require.NoError(t, err)
+	require.Nil(t, res.DistributionProgress)
+	require.Equal(t, types.Dissolution{
+		Height:      ctx.BlockHeight(),
+		Time:        ctx.BlockTime(),
+		HaltHeight:  100,
+		Distributed: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), sdk.NewInt64Coin("uatom", 10)),
+		Recipients:  2,
+	}, res.Dissolution)
+
+	genState, err = f.keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &res.Dissolution, genState.Dissolution)
+	require.Nil(t, genState.DistributionProgress)
+	require.Empty(t, genState.Holdings)
+	require.NoError(t, genState.Validate())
+}
+
+func TestDistributeShortPool(t *testing.T) {
+	t.Parallel()
+
+	f := setupFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+
+	holderA := sdk.AccAddress("holder_a____________")
+	holderB := sdk.AccAddress("holder_b____________")
+	for _, holder := range []sdk.AccAddress{holderA, holderB} {
+		require.NoError(t, f.accounts.Set(f.ctx, holder, authtypes.NewBaseAccountWithAddress(holder)))
+	}
+	f.bankKeeper.balances[string(holderA)] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+	f.bankKeeper.balances[string(holderB)] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))
+	f.distrKeeper.feePool.CommunityPool = sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 1000))
+
+	ctx := f.at(f.start)
+	_, err := msgServer.Dissolve(ctx, types.NewMsgDissolve(f.authority, 100))
+	require.NoError(t, err)
+
+	// Tally the holdings and snapshot the pool
+	require.NoError(t, f.keeper.DistributeCommunityPool(ctx))
+	require.NoError(t, f.keeper.DistributeCommunityPool(ctx))
+
+	// The pool is spent below the snapshot before the holders are paid, so
+	// B, paid last, gets what is left rather than its share of 400
+	f.distrKeeper.feePool.CommunityPool = sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 700))
+	require.NoError(t, f.keeper.DistributeCommunityPool(ctx))
+	require.Equal(t, map[string]sdk.Coins{
+		holderA.String(): sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600)),
+		holderB.String(): sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+	}, f.distrKeeper.paid)
+	require.True(t, f.distrKeeper.feePool.CommunityPool.IsZero())
+
+	require.NoError(t, f.keeper.DistributeCommunityPool(ctx))
+	dissolution, err := f.keeper.Dissolution.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 700)), dissolution.Distributed)
+	require.Equal(t, uint64(2), dissolution.Recipients)
+	has, err := f.keeper.DistributionProgress.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestWindDownWatchdog(t *testing.T) {
+	t.Parallel()
+
+	f := setupFixture(t)
+	params := types.DefaultParams()
+	params.HaltOnDeadline = true
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	deadline := f.start.Add(types.DefaultInactivityPeriod)
+	ctx := f.at(deadline)
+	require.NoError(t, f.keeper.CheckDeadline(ctx))
+	msgDelegate := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+	msgUndelegate := sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{})
+	require.True(t, f.disableList[msgDelegate])
+	require.True(t, f.disableList[msgUndelegate])
+
+	// The proposal dissolving the DAO enables again what the watchdog
+	// disabled, but new delegations
+	_, err := f.keeper.Dissolve(ctx, 100)
+	require.NoError(t, err)
+	f.proposals[1] = govv1.Proposal{Id: 1, Status: govv1.StatusPassed}
+	require.NoError(t, f.keeper.Hooks().AfterProposalVotingPeriodEnded(ctx, 1))
+	require.True(t, f.disableList[msgDelegate])
+	require.False(t, f.disableList[msgUndelegate])
+
+	// There is no deadline once the DAO dissolved
+	ctx = f.at(ctx.BlockTime().Add(types.DefaultInactivityPeriod + time.Hour))
+	require.NoError(t, f.keeper.CheckDeadline(ctx))
+	require.Empty(t, eventTypes(ctx))
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/unicorn-research/chain/x/dissolution/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the dissolution module's state from a provided
+// genesis state. A watchdog without a last activity starts the inactivity
+// period at genesis.
+func (k Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState) error {
+	if err := k.Params.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	watchdog := genState.Watchdog
+	if watchdog.LastActivity.IsZero() {
+		watchdog.LastActivity = sdk.UnwrapSDKContext(ctx).BlockTime()
+	}
+
+	if err := k.Watchdog.Set(ctx, watchdog); err != nil {
+		return err
+	}
+
+	if genState.Dissolution != nil {
+		if err := k.Dissolution.Set(ctx, *genState.Dissolution); err != nil {
+			return err
+		}
+	}
+
+	if genState.DistributionProgress != nil {
+		if err := k.DistributionProgress.Set(ctx, *genState.DistributionProgress); err != nil {
+			return err
+		}
+	}
+
+	for _, holding := range genState.Holdings {
+		holder, err := k.ak.AddressCodec().StringToBytes(holding.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.Holdings.Set(ctx, holder, holding.Amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the dissolution module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	watchdog, err := k.Watchdog.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.NewGenesisState(params, watchdog)
+
+	dissolution, err := k.Dissolution.Get(ctx)
+	switch {
+	case err == nil:
+		genState.Dissolution = &dissolution
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+
+	progress, err := k.DistributionProgress.Get(ctx)
+	switch {
+	case err == nil:
+		genState.DistributionProgress = &progress
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+
+	err = k.Holdings.Walk(ctx, nil, func(holder sdk.AccAddress, amount math.Int) (bool, error) {
+		address, err := k.ak.AddressCodec().BytesToString(holder)
+		if err != nil {
+			return true, err
+		}
+		genState.Holdings = append(genState.Holdings, types.Holding{Address: address, Amount: amount})
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return genState, nil
+}
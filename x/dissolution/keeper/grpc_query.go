@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/unicorn-research/chain/x/dissolution/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+type queryServer struct {
+	k Keeper
+}
+
+// NewQueryServerImpl returns an implementation of the dissolution QueryServer
+// interface for the provided Keeper.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k: k}
+}
+
+// Params returns the dissolution parameters.
+func (q queryServer) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Watchdog returns the state of the inactivity watchdog, the deadline and the
+// number of days remaining until it.
+func (q queryServer) Watchdog(ctx context.Context, req *types.QueryWatchdogRequest) (*types.QueryWatchdogResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	watchdog, err := q.k.Watchdog.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryWatchdogResponse{
+		Watchdog:      watchdog,
+		Deadline:      watchdog.Deadline(params),
+		DaysRemaining: watchdog.DaysRemaining(params, sdk.UnwrapSDKContext(ctx).BlockTime()),
+	}, nil
+}
+
+// Dissolution returns the dissolution of the DAO by member vote, and the
+// progress of the distribution of the community pool until it ends.
+func (q queryServer) Dissolution(ctx context.Context, req *types.QueryDissolutionRequest) (*types.QueryDissolutionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	dissolution, err := q.k.Dissolution.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, types.ErrNotDissolved
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := &types.QueryDissolutionResponse{Dissolution: dissolution}
+	progress, err := q.k.DistributionProgress.Get(ctx)
+	switch {
+	case err == nil:
+		response.DistributionProgress = &progress
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// Hooks wraps the dissolution keeper to implement the governance hooks.
+type Hooks struct {
+	k Keeper
+}
+
+var _ govtypes.GovHooks = Hooks{}
+
+// Hooks returns the governance hooks of the dissolution keeper.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterProposalVotingPeriodEnded restarts the inactivity period when the
+// proposal passed.
+func (h Hooks) AfterProposalVotingPeriodEnded(ctx context.Context, proposalID uint64) error {
+	return h.k.RecordActivity(ctx, proposalID)
+}
+
+// AfterProposalSubmission implements the GovHooks interface.
+func (Hooks) AfterProposalSubmission(context.Context, uint64) error {
+	return nil
+}
+
+// AfterProposalDeposit implements the GovHooks interface.
+func (Hooks) AfterProposalDeposit(context.Context, uint64, sdk.AccAddress) error {
+	return nil
+}
+
+// AfterProposalVote implements the GovHooks interface.
+func (Hooks) AfterProposalVote(context.Context, uint64, sdk.AccAddress) error {
+	return nil
+}
+
+// AfterProposalFailedMinDeposit implements the GovHooks interface.
+func (Hooks) AfterProposalFailedMinDeposit(context.Context, uint64) error {
+	return nil
+}
@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/unicorn-research/chain/x/dissolution/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// Keeper of the dissolution store.
+type Keeper struct {
+	ak            types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
+	distrKeeper   types.DistributionKeeper
+	upgradeKeeper types.UpgradeKeeper
+	accounts      types.AccountStore
+	feePool       types.FeePoolStore
+	proposals     types.ProposalStore
+	disableList   types.CircuitDisableList
+	registry      codectypes.InterfaceRegistry
+
+	// the address capable of executing a MsgUpdateParams message, usually the gov module account
+	authority string
+
+	Schema               collections.Schema
+	Params               collections.Item[types.Params]
+	Watchdog             collections.Item[types.Watchdog]
+	Dissolution          collections.Item[types.Dissolution]
+	DistributionProgress collections.Item[types.DistributionProgress]
+	// Holdings of the bond denom tallied, keyed by holder, and not yet paid
+	Holdings collections.Map[sdk.AccAddress, math.Int]
+}
+
+// NewKeeper constructs a new dissolution keeper. The proposal store is read
+// when a voting period ends, and the circuit breaker's disable list halts the
+// message types the interface registry lists when the deadline passes. Once
+// the DAO dissolves, the holders in the account store are paid the community
+// pool read from the fee pool store.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sk types.StakingKeeper,
+	dk types.DistributionKeeper,
+	uk types.UpgradeKeeper,
+	accounts types.AccountStore,
+	feePool types.FeePoolStore,
+	proposals types.ProposalStore,
+	disableList types.CircuitDisableList,
+	registry codectypes.InterfaceRegistry,
+	authority string,
+) Keeper {
+	if _, err := ak.AddressCodec().StringToBytes(authority); err != nil {
+		panic(fmt.Errorf("invalid authority address: %w", err))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		ak:            ak,
+		bankKeeper:    bk,
+		stakingKeeper: sk,
+		distrKeeper:   dk,
+		upgradeKeeper: uk,
+		accounts:      accounts,
+		feePool:       feePool,
+		proposals:     proposals,
+		disableList:   disableList,
+		registry:      registry,
+		authority:     authority,
+		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Watchdog:      collections.NewItem(sb, types.WatchdogKey, "watchdog", codec.CollValue[types.Watchdog](cdc)),
+		Dissolution:   collections.NewItem(sb, types.DissolutionKey, "dissolution", codec.CollValue[types.Dissolution](cdc)),
+		DistributionProgress: collections.NewItem(
+			sb, types.DistributionProgressKey, "distribution_progress", codec.CollValue[types.DistributionProgress](cdc),
+		),
+		Holdings: collections.NewMap(sb, types.HoldingsKey, "holdings", sdk.AccAddressKey, sdk.IntValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the dissolution module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// RecordActivity restarts the inactivity period when the proposal passed,
+// enabling again the message types the watchdog disabled, but those the wind
+// down keeps disabled.
+//
+// It is the vote that counts: a proposal whose messages failed on execution,
+// as any does once the watchdog disabled them, passed all the same. x/gov
+// only marks a proposal failed without a vote when it cannot decode it, and
+// then ends its voting period without the hooks.
+func (k Keeper) RecordActivity(ctx context.Context, proposalID uint64) error {
+	proposal, err := k.proposals.Get(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	if proposal.Status != govv1.StatusPassed && proposal.Status != govv1.StatusFailed {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	watchdog, err := k.Watchdog.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	windingDown, err := k.Dissolution.Has(ctx)
+	if err != nil {
+		return err
+	}
+	windDownMsgTypes := make(map[string]bool)
+	if windingDown {
+		for _, msgType := range types.WindDownMsgTypes() {
+			windDownMsgTypes[msgType] = true
+		}
+	}
+
+	for _, msgType := range watchdog.DisabledMsgTypes {
+		if windDownMsgTypes[msgType] {
+			continue
+		}
+		if err := k.disableList.Remove(ctx, msgType); err != nil {
+			return err
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	watchdog = types.Watchdog{
+		LastPassedProposalId: proposalID,
+		LastActivity:         sdkCtx.BlockTime(),
+	}
+	if err := k.Watchdog.Set(ctx, watchdog); err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventProposalActivity{
+		ProposalId: proposalID,
+		Deadline:   watchdog.Deadline(params),
+	})
+}
+
+// CheckDeadline emits a warning when the deadline nears a new threshold, and
+// marks the deadline passed once it does, halting the chain when the params
+// say so. Once the DAO dissolved by member vote there is no deadline.
+func (k Keeper) CheckDeadline(ctx context.Context) error {
+	windingDown, err := k.Dissolution.Has(ctx)
+	if err != nil || windingDown {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	watchdog, err := k.Watchdog.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if watchdog.DeadlinePassed {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	deadline := watchdog.Deadline(params)
+	if !sdkCtx.BlockTime().Before(deadline) {
+		return k.passDeadline(ctx, params, watchdog)
+	}
+
+	daysRemaining := watchdog.DaysRemaining(params, sdkCtx.BlockTime())
+	level := params.WarningLevel(daysRemaining)
+	if level <= watchdog.WarningLevel {
+		return nil
+	}
+
+	watchdog.WarningLevel = level
+	if err := k.Watchdog.Set(ctx, watchdog); err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventDissolutionWarning{
+		DaysRemaining: daysRemaining,
+		Threshold:     params.WarningDays[level-1],
+		Level:         level,
+		Deadline:      deadline,
+	})
+}
+
+// passDeadline marks the deadline passed and, when the params say so, disables
+// every message type but the exempt ones through the circuit breaker. The
+// message types the circuit breaker already disabled are left to its
+// authority.
+func (k Keeper) passDeadline(ctx context.Context, params types.Params, watchdog types.Watchdog) error {
+	watchdog.DeadlinePassed = true
+
+	if params.HaltOnDeadline {
+		exempt := make(map[string]bool, len(params.ExemptMsgTypes))
+		for _, msgType := range params.ExemptMsgTypes {
+			exempt[msgType] = true
+		}
+
+		msgTypes := k.registry.ListImplementations(sdk.MsgInterfaceProtoName)
+		sort.Strings(msgTypes)
+		for _, msgType := range msgTypes {
+			if exempt[msgType] {
+				continue
+			}
+
+			disabled, err := k.disableList.Has(ctx, msgType)
+			if err != nil {
+				return err
+			}
+			if disabled {
+				continue
+			}
+
+			if err := k.disableList.Set(ctx, msgType); err != nil {
+				return err
+			}
+			watchdog.DisabledMsgTypes = append(watchdog.DisabledMsgTypes, msgType)
+		}
+	}
+
+	if err := k.Watchdog.Set(ctx, watchdog); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventDissolutionDeadlinePassed{
+		LastPassedProposalId: watchdog.LastPassedProposalId,
+		Deadline:             watchdog.Deadline(params),
+		Halted:               params.HaltOnDeadline,
+	})
+}
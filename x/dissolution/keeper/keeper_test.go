@@ -0,0 +1,370 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/unicorn-research/chain/x/dissolution"
+	"github.com/unicorn-research/chain/x/dissolution/keeper"
+	"github.com/unicorn-research/chain/x/dissolution/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+type mockAccountKeeper struct{}
+
+func (mockAccountKeeper) AddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec("cosmos")
+}
+
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func (bk *mockBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, bk.balances[string(addr)].AmountOf(denom))
+}
+
+type mockStakingKeeper struct {
+	validators  map[string]stakingtypes.Validator
+	delegations []stakingtypes.Delegation
+}
+
+func (*mockStakingKeeper) ValidatorAddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec("cosmosvaloper")
+}
+
+func (*mockStakingKeeper) BondDenom(context.Context) (string, error) {
+	return sdk.DefaultBondDenom, nil
+}
+
+func (sk *mockStakingKeeper) GetDelegatorDelegations(_ context.Context, delegator sdk.AccAddress, maxRetrieve uint16) ([]stakingtypes.Delegation, error) {
+	var delegations []stakingtypes.Delegation
+	for _, delegation := range sk.delegations {
+		if delegation.DelegatorAddress == delegator.String() && len(delegations) < int(maxRetrieve) {
+			delegations = append(delegations, delegation)
+		}
+	}
+
+	return delegations, nil
+}
+
+func (sk *mockStakingKeeper) GetValidator(_ context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	validator, ok := sk.validators[string(addr)]
+	if !ok {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+
+	return validator, nil
+}
+
+type mockDistrKeeper struct {
+	feePool distrtypes.FeePool
+	paid    map[string]sdk.Coins
+}
+
+func (dk *mockDistrKeeper) Get(context.Context) (distrtypes.FeePool, error) {
+	return dk.feePool, nil
+}
+
+func (dk *mockDistrKeeper) DistributeFromFeePool(_ context.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error {
+	pool, negative := dk.feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(amount...))
+	if negative {
+		return distrtypes.ErrBadDistribution
+	}
+
+	dk.feePool.CommunityPool = pool
+	dk.paid[receiveAddr.String()] = dk.paid[receiveAddr.String()].Add(amount...)
+	return nil
+}
+
+type mockUpgradeKeeper struct {
+	plan *upgradetypes.Plan
+}
+
+func (uk *mockUpgradeKeeper) ScheduleUpgrade(_ context.Context, plan upgradetypes.Plan) error {
+	uk.plan = &plan
+	return nil
+}
+
+type mockProposalStore map[uint64]govv1.Proposal
+
+func (s mockProposalStore) Get(_ context.Context, proposalID uint64) (govv1.Proposal, error) {
+	proposal, ok := s[proposalID]
+	if !ok {
+		return govv1.Proposal{}, collections.ErrNotFound
+	}
+
+	return proposal, nil
+}
+
+type mockDisableList map[string]bool
+
+func (l mockDisableList) Has(_ context.Context, msgTypeURL string) (bool, error) {
+	return l[msgTypeURL], nil
+}
+
+func (l mockDisableList) Set(_ context.Context, msgTypeURL string) error {
+	l[msgTypeURL] = true
+	return nil
+}
+
+func (l mockDisableList) Remove(_ context.Context, msgTypeURL string) error {
+	delete(l, msgTypeURL)
+	return nil
+}
+
+type fixture struct {
+	ctx           sdk.Context
+	keeper        keeper.Keeper
+	authority     string
+	accounts      collections.Map[sdk.AccAddress, sdk.AccountI]
+	bankKeeper    *mockBankKeeper
+	stakingKeeper *mockStakingKeeper
+	distrKeeper   *mockDistrKeeper
+	upgradeKeeper *mockUpgradeKeeper
+	proposals     mockProposalStore
+	disableList   mockDisableList
+	start         time.Time
+}
+
+func setupFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(dissolution.AppModuleBasic{})
+	authtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	banktypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	govv1.RegisterInterfaces(encCfg.InterfaceRegistry)
+	stakingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	ak := mockAccountKeeper{}
+	authority, err := ak.AddressCodec().BytesToString(authtypes.NewModuleAddress(govtypes.ModuleName))
+	require.NoError(t, err)
+
+	// The accounts are stored apart from the keeper's state, as x/auth would
+	storeService := runtime.NewKVStoreService(key)
+	accounts := collections.NewMap(
+		collections.NewSchemaBuilder(storeService), collections.NewPrefix(100), "accounts",
+		sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](encCfg.Codec),
+	)
+
+	bk := &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+	sk := &mockStakingKeeper{validators: make(map[string]stakingtypes.Validator)}
+	dk := &mockDistrKeeper{paid: make(map[string]sdk.Coins)}
+	uk := &mockUpgradeKeeper{}
+	proposals := make(mockProposalStore)
+	disableList := make(mockDisableList)
+	k := keeper.NewKeeper(encCfg.Codec, storeService, ak, bk, sk, dk, uk, accounts, dk, proposals, disableList, encCfg.InterfaceRegistry, authority)
+
+	start := time.Unix(1_700_000_000, 0).UTC()
+	ctx := testCtx.Ctx.WithBlockHeight(1).WithBlockTime(start)
+	require.NoError(t, k.InitGenesis(ctx, types.DefaultGenesisState()))
+
+	return &fixture{
+		ctx:           ctx,
+		keeper:        k,
+		authority:     authority,
+		accounts:      accounts,
+		bankKeeper:    bk,
+		stakingKeeper: sk,
+		distrKeeper:   dk,
+		upgradeKeeper: uk,
+		proposals:     proposals,
+		disableList:   disableList,
+		start:         start,
+	}
+}
+
+// at returns the fixture's context at a block time, with a new event manager.
+func (f *fixture) at(blockTime time.Time) sdk.Context {
+	return f.ctx.WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+}
+
+func eventTypes(ctx sdk.Context) []string {
+	var eventTypes []string
+	for _, event := range ctx.EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+
+	return eventTypes
+}
+
+func TestInitGenesis(t *testing.T) {
+	t.Parallel()
+
+	f := setupFixture(t)
+
+	// The inactivity period starts at genesis
+	watchdog, err := f.keeper.Watchdog.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Watchdog{LastActivity: f.start}, watchdog)
+
+	genState, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.NewGenesisState(types.DefaultParams(), watchdog), genState)
+}
+
+func TestCheckDeadline(t *testing.T) {
+	t.Parallel()
+
+	f := setupFixture(t)
+	deadline := f.start.Add(types.DefaultInactivityPeriod)
+
+	ctx := f.at(deadline.Add(-91 * types.Day))
+	require.NoError(t, f.keeper.CheckDeadline(ctx))
+	require.Empty(t, eventTypes(ctx))
+
+	// Each threshold is warned about once
+	ctx = f.at(deadline.Add(-90 * types.Day))
+	require.NoError(t, f.keeper.CheckDeadline(ctx))
+	require.Equal(t, []string{"unicorn.dissolution.v1.EventDissolutionWarning"}, eventTypes(ctx))
+
+	ctx = f.at(deadline.Add(-89 * types.Day))
+	require.NoError(t, f.keeper.CheckDeadline(ctx))
+	require.Empty(t, eventTypes(ctx))
+
+	// Crossing several thresholds at once warns about the last one
+	ctx = f.at(deadline.Add(-time.Hour))
+	require.NoError(t, f.keeper.CheckDeadline(ctx))
+	require.Equal(t, []string{"unicorn.dissolution.v1.EventDissolutionWarning"}, eventTypes(ctx))
+
+	watchdog, err := f.keeper.Watchdog.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), watchdog.WarningLevel)
+
+	ctx = f.at(deadline)
+	require.NoError(t, f.keeper.CheckDeadline(ctx))
+	require.Equal(t, []string{"unicorn.dissolution.v1.EventDissolutionDeadlinePassed"}, eventTypes(ctx))
+
+	watchdog, err = f.keeper.Watchdog.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, watchdog.DeadlinePassed)
+	require.Empty(t, watchdog.DisabledMsgTypes)
+	require.Empty(t, f.disableList)
+
+	// The deadline passes once
+	ctx = f.at(deadline.Add(types.Day))
+	require.NoError(t, f.keeper.CheckDeadline(ctx))
+	require.Empty(t, eventTypes(ctx))
+}
+
+func TestHaltOnDeadline(t *testing.T) {
+	t.Parallel()
+
+	f := setupFixture(t)
+	params := types.DefaultParams()
+	params.HaltOnDeadline = true
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	msgSend := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgMultiSend := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+	msgVote := sdk.MsgTypeURL(&govv1.MsgVote{})
+	require.NoError(t, f.disableList.Set(f.ctx, msgMultiSend))
+
+	ctx := f.at(f.start.Add(types.DefaultInactivityPeriod))
+	require.NoError(t, f.keeper.CheckDeadline(ctx))
+
+	// Every message type but the exempt ones is disabled
+	require.True(t, f.disableList[msgSend])
+	require.True(t, f.disableList[sdk.MsgTypeURL(&types.MsgUpdateParams{})])
+	require.False(t, f.disableList[msgVote])
+
+	watchdog, err := f.keeper.Watchdog.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, watchdog.DeadlinePassed)
+	require.Contains(t, watchdog.DisabledMsgTypes, msgSend)
+	require.NotContains(t, watchdog.DisabledMsgTypes, msgMultiSend)
+	require.NotContains(t, watchdog.DisabledMsgTypes, msgVote)
+
+	// A passed proposal enables them again, but those the circuit breaker
+	// authority disabled, even when its disabled messages failed on execution
+	f.proposals[5] = govv1.Proposal{Id: 5, Status: govv1.StatusFailed}
+	ctx = f.at(f.start.Add(types.DefaultInactivityPeriod + types.Day))
+	require.NoError(t, f.keeper.Hooks().AfterProposalVotingPeriodEnded(ctx, 5))
+	require.Equal(t, mockDisableList{msgMultiSend: true}, f.disableList)
+
+	watchdog, err = f.keeper.Watchdog.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Watchdog{LastPassedProposalId: 5, LastActivity: ctx.BlockTime()}, watchdog)
+}
+
+func TestRecordActivity(t *testing.T) {
+	t.Parallel()
+
+	f := setupFixture(t)
+	f.proposals[1] = govv1.Proposal{Id: 1, Status: govv1.StatusRejected}
+	f.proposals[2] = govv1.Proposal{Id: 2, Status: govv1.StatusPassed}
+	f.proposals[4] = govv1.Proposal{Id: 4, Status: govv1.StatusFailed}
+
+	ctx := f.at(f.start.Add(300 * types.Day))
+	require.NoError(t, f.keeper.CheckDeadline(ctx))
+	ctx = f.at(ctx.BlockTime())
+
+	// Only proposals that passed restart the inactivity period
+	require.NoError(t, f.keeper.RecordActivity(ctx, 1))
+	require.Empty(t, eventTypes(ctx))
+	require.ErrorIs(t, f.keeper.RecordActivity(ctx, 3), collections.ErrNotFound)
+
+	require.NoError(t, f.keeper.RecordActivity(ctx, 2))
+	require.Equal(t, []string{"unicorn.dissolution.v1.EventProposalActivity"}, eventTypes(ctx))
+
+	watchdog, err := f.keeper.Watchdog.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Watchdog{LastPassedProposalId: 2, LastActivity: ctx.BlockTime()}, watchdog)
+
+	res, err := keeper.NewQueryServerImpl(f.keeper).Watchdog(ctx, &types.QueryWatchdogRequest{})
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultInactivityPeriod), res.Deadline)
+	require.Equal(t, uint64(365), res.DaysRemaining)
+
+	// A proposal that passed but failed on execution counts as well
+	ctx = f.at(ctx.BlockTime().Add(types.Day))
+	require.NoError(t, f.keeper.RecordActivity(ctx, 4))
+	require.Equal(t, []string{"unicorn.dissolution.v1.EventProposalActivity"}, eventTypes(ctx))
+
+	watchdog, err = f.keeper.Watchdog.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Watchdog{LastPassedProposalId: 4, LastActivity: ctx.BlockTime()}, watchdog)
+}
+
+func TestUpdateParams(t *testing.T) {
+	t.Parallel()
+
+	f := setupFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	params := types.NewParams(30*types.Day, []uint64{7}, true, types.DefaultExemptMsgTypes())
+
+	// Only the authority can update the params
+	_, err := msgServer.UpdateParams(f.ctx, types.NewMsgUpdateParams("cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysn3k7pr0", params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.UpdateParams(f.ctx, types.NewMsgUpdateParams(f.authority, types.NewParams(30*types.Day, []uint64{30}, true, nil)))
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+
+	_, err = msgServer.UpdateParams(f.ctx, types.NewMsgUpdateParams(f.authority, params))
+	require.NoError(t, err)
+
+	res, err := keeper.NewQueryServerImpl(f.keeper).Params(f.ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+}
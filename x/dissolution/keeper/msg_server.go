@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/unicorn-research/chain/x/dissolution/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the dissolution MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// UpdateParams updates the dissolution parameters.
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// Dissolve dissolves the DAO, winding the chain down until it halts and
+// starting the distribution of the community pool to the token holders.
+func (k msgServer) Dissolve(ctx context.Context, msg *types.MsgDissolve) (*types.MsgDissolveResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if _, err := k.Keeper.Dissolve(ctx, msg.HaltHeight); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDissolveResponse{}, nil
+}
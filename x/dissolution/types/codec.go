@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/dissolution interfaces
+// and concrete types on the provided LegacyAmino codec. These types are used
+// for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "unicorn/x/dissolution/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgDissolve{}, "unicorn/x/dissolution/MsgDissolve")
+	cdc.RegisterConcrete(&Params{}, "unicorn/x/dissolution/Params", nil)
+}
+
+// RegisterInterfaces registers the x/dissolution interfaces types with the
+// interface registry.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgDissolve{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	circuittypes "github.com/cosmos/cosmos-sdk/x/circuit/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// DistributionBatchSize is the number of accounts the distribution of the
+// community pool tallies, or pays, in a block.
+const DistributionBatchSize = 100
+
+// WindDownMsgTypes are the messages the circuit breaker disables while the
+// chain winds down: those creating or moving delegations, sending coins
+// between accounts, spending the community pool being distributed, and
+// changing the x/upgrade plan halting the chain or the circuit breaker
+// keeping it.
+func WindDownMsgTypes() []string {
+	return []string{
+		sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}),
+		sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+		sdk.MsgTypeURL(&vestingtypes.MsgCreateVestingAccount{}),
+		sdk.MsgTypeURL(&vestingtypes.MsgCreatePermanentLockedAccount{}),
+		sdk.MsgTypeURL(&vestingtypes.MsgCreatePeriodicVestingAccount{}),
+		sdk.MsgTypeURL(&distrtypes.MsgCommunityPoolSpend{}),
+		sdk.MsgTypeURL(&upgradetypes.MsgSoftwareUpgrade{}),
+		sdk.MsgTypeURL(&upgradetypes.MsgCancelUpgrade{}),
+		sdk.MsgTypeURL(&circuittypes.MsgResetCircuitBreaker{}),
+	}
+}
+
+// Validate performs a basic validation of the dissolution.
+func (d Dissolution) Validate() error {
+	if d.Height < 0 {
+		return errors.Wrapf(ErrInvalidDissolution, "negative height %d", d.Height)
+	}
+	if d.HaltHeight <= d.Height {
+		return errors.Wrapf(ErrInvalidDissolution, "halt height %d is not after the dissolution height %d", d.HaltHeight, d.Height)
+	}
+	if err := d.Distributed.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidDissolution, "invalid distribution: %s", err)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the distribution progress.
+func (p DistributionProgress) Validate() error {
+	if p.TotalHoldings.IsNil() || p.TotalHoldings.IsNegative() {
+		return errors.Wrapf(ErrInvalidDissolution, "invalid total holdings %s", p.TotalHoldings)
+	}
+	if err := p.Pool.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidDissolution, "invalid distributed pool: %s", err)
+	}
+	if !p.Paying && !p.Pool.IsZero() {
+		return errors.Wrap(ErrInvalidDissolution, "the pool is set before every account is tallied")
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the holding.
+func (h Holding) Validate() error {
+	if h.Address == "" {
+		return errors.Wrap(ErrInvalidDissolution, "holding without an address")
+	}
+	if h.Amount.IsNil() || !h.Amount.IsPositive() {
+		return errors.Wrapf(ErrInvalidDissolution, "holding of %s is not positive", h.Address)
+	}
+
+	return nil
+}
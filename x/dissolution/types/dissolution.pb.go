@@ -0,0 +1,2956 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: unicorn/dissolution/v1/dissolution.proto
+
+package types
+
+import (
+	_ "cosmossdk.io/api/amino"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the dissolution module.
+type Params struct {
+	// inactivity_period is how long the DAO can go without approving a proposal
+	// before it dissolves, one year under W.S. 17-31-114(a)(iv).
+	InactivityPeriod time.Duration `protobuf:"bytes,1,opt,name=inactivity_period,json=inactivityPeriod,proto3,stdduration" json:"inactivity_period"`
+	// warning_days are the numbers of days before the deadline at which an
+	// escalating warning is emitted, in decreasing order.
+	WarningDays []uint64 `protobuf:"varint,2,rep,packed,name=warning_days,json=warningDays,proto3" json:"warning_days,omitempty"`
+	// halt_on_deadline disables every message type but the exempt ones through
+	// the circuit breaker once the deadline passes.
+	HaltOnDeadline bool `protobuf:"varint,3,opt,name=halt_on_deadline,json=haltOnDeadline,proto3" json:"halt_on_deadline,omitempty"`
+	// exempt_msg_types are the type URLs of the messages that stay enabled when
+	// the chain halts, so members can still govern the wind-down.
+	ExemptMsgTypes []string `protobuf:"bytes,4,rep,name=exempt_msg_types,json=exemptMsgTypes,proto3" json:"exempt_msg_types,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebe38594a9220fc9, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetInactivityPeriod() time.Duration {
+	if m != nil {
+		return m.InactivityPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetWarningDays() []uint64 {
+	if m != nil {
+		return m.WarningDays
+	}
+	return nil
+}
+
+func (m *Params) GetHaltOnDeadline() bool {
+	if m != nil {
+		return m.HaltOnDeadline
+	}
+	return false
+}
+
+func (m *Params) GetExemptMsgTypes() []string {
+	if m != nil {
+		return m.ExemptMsgTypes
+	}
+	return nil
+}
+
+// Watchdog is the state of the governance inactivity watchdog.
+type Watchdog struct {
+	// last_passed_proposal_id is the id of the last proposal that passed, and 0
+	// when none passed since the watchdog started.
+	LastPassedProposalId uint64 `protobuf:"varint,1,opt,name=last_passed_proposal_id,json=lastPassedProposalId,proto3" json:"last_passed_proposal_id,omitempty"`
+	// last_activity is when the inactivity period started: the end of the voting
+	// period of the last passed proposal, or when the watchdog started.
+	LastActivity time.Time `protobuf:"bytes,2,opt,name=last_activity,json=lastActivity,proto3,stdtime" json:"last_activity"`
+	// warning_level is the number of warning thresholds crossed since the last
+	// activity.
+	WarningLevel uint64 `protobuf:"varint,3,opt,name=warning_level,json=warningLevel,proto3" json:"warning_level,omitempty"`
+	// deadline_passed is whether the inactivity period ended without a passed
+	// proposal. A later passed proposal restarts the period and resets it.
+	DeadlinePassed bool `protobuf:"varint,4,opt,name=deadline_passed,json=deadlinePassed,proto3" json:"deadline_passed,omitempty"`
+	// disabled_msg_types are the type URLs of the messages the watchdog disabled
+	// through the circuit breaker when the deadline passed. They are enabled
+	// again when a proposal passes.
+	DisabledMsgTypes []string `protobuf:"bytes,5,rep,name=disabled_msg_types,json=disabledMsgTypes,proto3" json:"disabled_msg_types,omitempty"`
+}
+
+func (m *Watchdog) Reset()         { *m = Watchdog{} }
+func (m *Watchdog) String() string { return proto.CompactTextString(m) }
+func (*Watchdog) ProtoMessage()    {}
+func (*Watchdog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebe38594a9220fc9, []int{1}
+}
+func (m *Watchdog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Watchdog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Watchdog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Watchdog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Watchdog.Merge(m, src)
+}
+func (m *Watchdog) XXX_Size() int {
+	return m.Size()
+}
+func (m *Watchdog) XXX_DiscardUnknown() {
+	xxx_messageInfo_Watchdog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Watchdog proto.InternalMessageInfo
+
+func (m *Watchdog) GetLastPassedProposalId() uint64 {
+	if m != nil {
+		return m.LastPassedProposalId
+	}
+	return 0
+}
+
+func (m *Watchdog) GetLastActivity() time.Time {
+	if m != nil {
+		return m.LastActivity
+	}
+	return time.Time{}
+}
+
+func (m *Watchdog) GetWarningLevel() uint64 {
+	if m != nil {
+		return m.WarningLevel
+	}
+	return 0
+}
+
+func (m *Watchdog) GetDeadlinePassed() bool {
+	if m != nil {
+		return m.DeadlinePassed
+	}
+	return false
+}
+
+func (m *Watchdog) GetDisabledMsgTypes() []string {
+	if m != nil {
+		return m.DisabledMsgTypes
+	}
+	return nil
+}
+
+// Dissolution records the dissolution of the DAO by member vote under W.S.
+// 17-31-114(a)(ii), which winds the chain down until it halts.
+type Dissolution struct {
+	// height is the block height of the dissolution.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the dissolution.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// halt_height is the height of the x/upgrade plan halting the chain.
+	HaltHeight int64 `protobuf:"varint,3,opt,name=halt_height,json=haltHeight,proto3" json:"halt_height,omitempty"`
+	// distributed is the part of the community pool distributed to the token
+	// holders so far.
+	Distributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=distributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed"`
+	// recipients is the number of token holders the distribution paid so far.
+	Recipients uint64 `protobuf:"varint,5,opt,name=recipients,proto3" json:"recipients,omitempty"`
+}
+
+func (m *Dissolution) Reset()         { *m = Dissolution{} }
+func (m *Dissolution) String() string { return proto.CompactTextString(m) }
+func (*Dissolution) ProtoMessage()    {}
+func (*Dissolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebe38594a9220fc9, []int{2}
+}
+func (m *Dissolution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Dissolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Dissolution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Dissolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dissolution.Merge(m, src)
+}
+func (m *Dissolution) XXX_Size() int {
+	return m.Size()
+}
+func (m *Dissolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dissolution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Dissolution proto.InternalMessageInfo
+
+func (m *Dissolution) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Dissolution) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Dissolution) GetHaltHeight() int64 {
+	if m != nil {
+		return m.HaltHeight
+	}
+	return 0
+}
+
+func (m *Dissolution) GetDistributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Distributed
+	}
+	return nil
+}
+
+func (m *Dissolution) GetRecipients() uint64 {
+	if m != nil {
+		return m.Recipients
+	}
+	return 0
+}
+
+// DistributionProgress is the state of the distribution of the community pool,
+// which runs over the blocks after the dissolution: the holdings of every
+// account are tallied first, then every holder is paid its share.
+type DistributionProgress struct {
+	// paying is whether every account is tallied and the holders are being
+	// paid.
+	Paying bool `protobuf:"varint,1,opt,name=paying,proto3" json:"paying,omitempty"`
+	// cursor is the address of the last account tallied, after which the
+	// tally continues.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// total_holdings is the sum of the holdings tallied.
+	TotalHoldings cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_holdings,json=totalHoldings,proto3,customtype=cosmossdk.io/math.Int" json:"total_holdings"`
+	// pool is the community pool paid out, set once every account is tallied.
+	Pool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=pool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool"`
+}
+
+func (m *DistributionProgress) Reset()         { *m = DistributionProgress{} }
+func (m *DistributionProgress) String() string { return proto.CompactTextString(m) }
+func (*DistributionProgress) ProtoMessage()    {}
+func (*DistributionProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebe38594a9220fc9, []int{3}
+}
+func (m *DistributionProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionProgress.Merge(m, src)
+}
+func (m *DistributionProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionProgress proto.InternalMessageInfo
+
+func (m *DistributionProgress) GetPaying() bool {
+	if m != nil {
+		return m.Paying
+	}
+	return false
+}
+
+func (m *DistributionProgress) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *DistributionProgress) GetPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+// Holding is the amount of the bond denom an account held, in its balance or
+// delegated, when the distribution tallied it.
+type Holding struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the amount of the bond denom the account held.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *Holding) Reset()         { *m = Holding{} }
+func (m *Holding) String() string { return proto.CompactTextString(m) }
+func (*Holding) ProtoMessage()    {}
+func (*Holding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebe38594a9220fc9, []int{4}
+}
+func (m *Holding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Holding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Holding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Holding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Holding.Merge(m, src)
+}
+func (m *Holding) XXX_Size() int {
+	return m.Size()
+}
+func (m *Holding) XXX_DiscardUnknown() {
+	xxx_messageInfo_Holding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Holding proto.InternalMessageInfo
+
+func (m *Holding) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// EventDissolutionWarning is emitted when the deadline crosses a warning
+// threshold.
+type EventDissolutionWarning struct {
+	// days_remaining is the number of days until the deadline.
+	DaysRemaining uint64 `protobuf:"varint,1,opt,name=days_remaining,json=daysRemaining,proto3" json:"days_remaining,omitempty"`
+	// threshold is the crossed threshold, in days.
+	Threshold uint64 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// level is the number of thresholds crossed, increasing as the deadline
+	// nears.
+	Level uint64 `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	// deadline is when the DAO dissolves unless a proposal passes.
+	Deadline time.Time `protobuf:"bytes,4,opt,name=deadline,proto3,stdtime" json:"deadline"`
+}
+
+func (m *EventDissolutionWarning) Reset()         { *m = EventDissolutionWarning{} }
+func (m *EventDissolutionWarning) String() string { return proto.CompactTextString(m) }
+func (*EventDissolutionWarning) ProtoMessage()    {}
+func (*EventDissolutionWarning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebe38594a9220fc9, []int{5}
+}
+func (m *EventDissolutionWarning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDissolutionWarning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDissolutionWarning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDissolutionWarning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDissolutionWarning.Merge(m, src)
+}
+func (m *EventDissolutionWarning) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDissolutionWarning) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDissolutionWarning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDissolutionWarning proto.InternalMessageInfo
+
+func (m *EventDissolutionWarning) GetDaysRemaining() uint64 {
+	if m != nil {
+		return m.DaysRemaining
+	}
+	return 0
+}
+
+func (m *EventDissolutionWarning) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *EventDissolutionWarning) GetLevel() uint64 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
+func (m *EventDissolutionWarning) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return time.Time{}
+}
+
+// EventDissolutionDeadlinePassed is emitted when the inactivity period ends
+// without a passed proposal.
+type EventDissolutionDeadlinePassed struct {
+	// last_passed_proposal_id is the id of the last passed proposal.
+	LastPassedProposalId uint64 `protobuf:"varint,1,opt,name=last_passed_proposal_id,json=lastPassedProposalId,proto3" json:"last_passed_proposal_id,omitempty"`
+	// deadline is when the inactivity period ended.
+	Deadline time.Time `protobuf:"bytes,2,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	// halted is whether the circuit breaker disabled the message types.
+	Halted bool `protobuf:"varint,3,opt,name=halted,proto3" json:"halted,omitempty"`
+}
+
+func (m *EventDissolutionDeadlinePassed) Reset()         { *m = EventDissolutionDeadlinePassed{} }
+func (m *EventDissolutionDeadlinePassed) String() string { return proto.CompactTextString(m) }
+func (*EventDissolutionDeadlinePassed) ProtoMessage()    {}
+func (*EventDissolutionDeadlinePassed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebe38594a9220fc9, []int{6}
+}
+func (m *EventDissolutionDeadlinePassed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDissolutionDeadlinePassed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDissolutionDeadlinePassed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDissolutionDeadlinePassed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDissolutionDeadlinePassed.Merge(m, src)
+}
+func (m *EventDissolutionDeadlinePassed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDissolutionDeadlinePassed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDissolutionDeadlinePassed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDissolutionDeadlinePassed proto.InternalMessageInfo
+
+func (m *EventDissolutionDeadlinePassed) GetLastPassedProposalId() uint64 {
+	if m != nil {
+		return m.LastPassedProposalId
+	}
+	return 0
+}
+
+func (m *EventDissolutionDeadlinePassed) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return time.Time{}
+}
+
+func (m *EventDissolutionDeadlinePassed) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+// EventProposalActivity is emitted when a passed proposal restarts the
+// inactivity period.
+type EventProposalActivity struct {
+	// proposal_id is the id of the passed proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// deadline is the new deadline.
+	Deadline time.Time `protobuf:"bytes,2,opt,name=deadline,proto3,stdtime" json:"deadline"`
+}
+
+func (m *EventProposalActivity) Reset()         { *m = EventProposalActivity{} }
+func (m *EventProposalActivity) String() string { return proto.CompactTextString(m) }
+func (*EventProposalActivity) ProtoMessage()    {}
+func (*EventProposalActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebe38594a9220fc9, []int{7}
+}
+func (m *EventProposalActivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProposalActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProposalActivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProposalActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProposalActivity.Merge(m, src)
+}
+func (m *EventProposalActivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProposalActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProposalActivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProposalActivity proto.InternalMessageInfo
+
+func (m *EventProposalActivity) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventProposalActivity) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return time.Time{}
+}
+
+// EventDissolved is emitted when the DAO dissolves by member vote and the
+// chain starts winding down.
+type EventDissolved struct {
+	// halt_height is the height of the x/upgrade plan halting the chain.
+	HaltHeight int64 `protobuf:"varint,1,opt,name=halt_height,json=haltHeight,proto3" json:"halt_height,omitempty"`
+}
+
+func (m *EventDissolved) Reset()         { *m = EventDissolved{} }
+func (m *EventDissolved) String() string { return proto.CompactTextString(m) }
+func (*EventDissolved) ProtoMessage()    {}
+func (*EventDissolved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebe38594a9220fc9, []int{8}
+}
+func (m *EventDissolved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDissolved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDissolved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDissolved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDissolved.Merge(m, src)
+}
+func (m *EventDissolved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDissolved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDissolved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDissolved proto.InternalMessageInfo
+
+func (m *EventDissolved) GetHaltHeight() int64 {
+	if m != nil {
+		return m.HaltHeight
+	}
+	return 0
+}
+
+// EventCommunityPoolDistributed is emitted when the distribution of the
+// community pool paid every token holder.
+type EventCommunityPoolDistributed struct {
+	// distributed is the part of the community pool distributed to the token
+	// holders.
+	Distributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=distributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed"`
+	// recipients is the number of token holders the distribution paid.
+	Recipients uint64 `protobuf:"varint,2,opt,name=recipients,proto3" json:"recipients,omitempty"`
+}
+
+func (m *EventCommunityPoolDistributed) Reset()         { *m = EventCommunityPoolDistributed{} }
+func (m *EventCommunityPoolDistributed) String() string { return proto.CompactTextString(m) }
+func (*EventCommunityPoolDistributed) ProtoMessage()    {}
+func (*EventCommunityPoolDistributed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebe38594a9220fc9, []int{9}
+}
+func (m *EventCommunityPoolDistributed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCommunityPoolDistributed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCommunityPoolDistributed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCommunityPoolDistributed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCommunityPoolDistributed.Merge(m, src)
+}
+func (m *EventCommunityPoolDistributed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCommunityPoolDistributed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCommunityPoolDistributed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCommunityPoolDistributed proto.InternalMessageInfo
+
+func (m *EventCommunityPoolDistributed) GetDistributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Distributed
+	}
+	return nil
+}
+
+func (m *EventCommunityPoolDistributed) GetRecipients() uint64 {
+	if m != nil {
+		return m.Recipients
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "unicorn.dissolution.v1.Params")
+	proto.RegisterType((*Watchdog)(nil), "unicorn.dissolution.v1.Watchdog")
+	proto.RegisterType((*Dissolution)(nil), "unicorn.dissolution.v1.Dissolution")
+	proto.RegisterType((*DistributionProgress)(nil), "unicorn.dissolution.v1.DistributionProgress")
+	proto.RegisterType((*Holding)(nil), "unicorn.dissolution.v1.Holding")
+	proto.RegisterType((*EventDissolutionWarning)(nil), "unicorn.dissolution.v1.EventDissolutionWarning")
+	proto.RegisterType((*EventDissolutionDeadlinePassed)(nil), "unicorn.dissolution.v1.EventDissolutionDeadlinePassed")
+	proto.RegisterType((*EventProposalActivity)(nil), "unicorn.dissolution.v1.EventProposalActivity")
+	proto.RegisterType((*EventDissolved)(nil), "unicorn.dissolution.v1.EventDissolved")
+	proto.RegisterType((*EventCommunityPoolDistributed)(nil), "unicorn.dissolution.v1.EventCommunityPoolDistributed")
+}
+
+func init() {
+	proto.RegisterFile("unicorn/dissolution/v1/dissolution.proto", fileDescriptor_ebe38594a9220fc9)
+}
+
+var fileDescriptor_ebe38594a9220fc9 = []byte{
+	// 982 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xda, 0xdb, 0x34, 0x19, 0x37, 0x26, 0x1d, 0xb9, 0xad, 0x6b, 0x15, 0x3b, 0x5d, 0x84,
+	0xb0, 0x22, 0xb2, 0x5b, 0x17, 0xf5, 0x82, 0xc4, 0xa1, 0xae, 0x8b, 0x12, 0x09, 0xa8, 0xb5, 0x14,
+	0x45, 0xe2, 0xb2, 0x1a, 0xef, 0x0e, 0xbb, 0xa3, 0xee, 0xce, 0xac, 0x76, 0x66, 0x4d, 0x7d, 0xe2,
+	0xc0, 0x01, 0x09, 0x09, 0xa9, 0x47, 0xc4, 0x5f, 0x80, 0xe0, 0x92, 0x43, 0xc5, 0x85, 0x33, 0x52,
+	0x8f, 0x55, 0x4f, 0x88, 0x43, 0x8b, 0x92, 0x43, 0xfe, 0x06, 0x6e, 0x68, 0x7e, 0x6c, 0xb2, 0x75,
+	0x0f, 0xa8, 0x11, 0x42, 0x5c, 0x12, 0xbf, 0xef, 0xcd, 0x7b, 0xf3, 0xbd, 0xef, 0xed, 0x7b, 0x03,
+	0x86, 0x25, 0x25, 0x21, 0x2b, 0xa8, 0x17, 0x11, 0xce, 0x59, 0x5a, 0x0a, 0xc2, 0xa8, 0x37, 0x1f,
+	0xd5, 0x4d, 0x37, 0x2f, 0x98, 0x60, 0xf0, 0xb2, 0x39, 0xe9, 0xd6, 0x5d, 0xf3, 0x51, 0xef, 0x22,
+	0xca, 0x08, 0x65, 0x9e, 0xfa, 0xab, 0x8f, 0xf6, 0xfa, 0x21, 0xe3, 0x19, 0xe3, 0xde, 0x0c, 0x71,
+	0xec, 0xcd, 0x47, 0x33, 0x2c, 0xd0, 0xc8, 0x0b, 0x19, 0x31, 0xa9, 0x7a, 0x57, 0xb5, 0x3f, 0x50,
+	0x96, 0xa7, 0x0d, 0xe3, 0xea, 0xc4, 0x2c, 0x66, 0x1a, 0x97, 0xbf, 0xaa, 0x84, 0x31, 0x63, 0x71,
+	0x8a, 0x3d, 0x65, 0xcd, 0xca, 0x2f, 0xbc, 0xa8, 0x2c, 0xd0, 0x29, 0xb7, 0xde, 0x60, 0xd9, 0x2f,
+	0x48, 0x86, 0xb9, 0x40, 0x59, 0xae, 0x0f, 0x38, 0x7f, 0x59, 0x60, 0x75, 0x8a, 0x0a, 0x94, 0x71,
+	0xf8, 0x19, 0xb8, 0x48, 0x28, 0x0a, 0x05, 0x99, 0x13, 0xb1, 0x08, 0x72, 0x5c, 0x10, 0x16, 0x75,
+	0xad, 0x2d, 0x6b, 0xd8, 0xba, 0x79, 0xd5, 0xd5, 0x79, 0xdc, 0x2a, 0x8f, 0x3b, 0x31, 0xf7, 0x8c,
+	0x37, 0x9e, 0x3c, 0x1f, 0xac, 0x7c, 0xff, 0x62, 0x60, 0xfd, 0x78, 0x7c, 0xb0, 0x6d, 0xf9, 0x9b,
+	0xa7, 0x29, 0xa6, 0x2a, 0x03, 0xbc, 0x0e, 0x2e, 0x7c, 0x89, 0x0a, 0x4a, 0x68, 0x1c, 0x44, 0x68,
+	0xc1, 0xbb, 0x8d, 0xad, 0xe6, 0xd0, 0xf6, 0x5b, 0x06, 0x9b, 0xa0, 0x05, 0x87, 0x43, 0xb0, 0x99,
+	0xa0, 0x54, 0x04, 0x8c, 0x06, 0x11, 0x46, 0x51, 0x4a, 0x28, 0xee, 0x36, 0xb7, 0xac, 0xe1, 0x9a,
+	0xdf, 0x96, 0xf8, 0x3d, 0x3a, 0x31, 0xa8, 0x3c, 0x89, 0x1f, 0xe2, 0x2c, 0x17, 0x41, 0xc6, 0xe3,
+	0x40, 0x2c, 0x72, 0xcc, 0xbb, 0xf6, 0x56, 0x73, 0xb8, 0xee, 0xb7, 0x35, 0xfe, 0x31, 0x8f, 0xef,
+	0x4b, 0xf4, 0xfd, 0xeb, 0xdf, 0x1e, 0x1f, 0x6c, 0x5f, 0xab, 0x9a, 0xf8, 0xf0, 0xa5, 0x36, 0xea,
+	0x82, 0x9d, 0xef, 0x1a, 0x60, 0x6d, 0x1f, 0x89, 0x30, 0x89, 0x58, 0x0c, 0x6f, 0x81, 0x2b, 0x29,
+	0xe2, 0x22, 0xc8, 0x11, 0xe7, 0x38, 0x92, 0x1d, 0xc8, 0x19, 0x47, 0x69, 0x40, 0xb4, 0x06, 0xb6,
+	0xdf, 0x91, 0xee, 0xa9, 0xf2, 0x4e, 0x8d, 0x73, 0x2f, 0x82, 0x9f, 0x80, 0x0d, 0x15, 0x56, 0x15,
+	0xdd, 0x6d, 0x28, 0xc1, 0x7a, 0xaf, 0x08, 0x76, 0xbf, 0x12, 0x5e, 0x2b, 0xf6, 0xe8, 0x44, 0xb1,
+	0x0b, 0x32, 0xfe, 0xb6, 0x09, 0x87, 0x6f, 0x81, 0x8d, 0x4a, 0xad, 0x14, 0xcf, 0x71, 0xaa, 0x74,
+	0xb0, 0xfd, 0x4a, 0xc2, 0x8f, 0x24, 0x06, 0xdf, 0x01, 0x6f, 0x54, 0x3a, 0x19, 0xbe, 0x5d, 0x5b,
+	0xcb, 0x55, 0xc1, 0x9a, 0x27, 0x7c, 0x17, 0xc0, 0x88, 0x70, 0x34, 0x4b, 0x71, 0x54, 0x13, 0xec,
+	0x9c, 0x12, 0x6c, 0xb3, 0xf2, 0x54, 0x92, 0x39, 0x3f, 0x37, 0x40, 0x6b, 0x72, 0x2a, 0x13, 0xbc,
+	0x0c, 0x56, 0x13, 0x4c, 0xe2, 0x44, 0x28, 0x05, 0x9a, 0xbe, 0xb1, 0xe0, 0x07, 0xc0, 0x96, 0x9f,
+	0xd1, 0xeb, 0x97, 0xaa, 0xc2, 0xe0, 0x00, 0xb4, 0x54, 0xb7, 0x4d, 0xee, 0xa6, 0xca, 0x0d, 0x24,
+	0xb4, 0xab, 0xf3, 0x7f, 0x6d, 0x81, 0x56, 0x44, 0xb8, 0x28, 0xc8, 0xac, 0x14, 0xaa, 0xb6, 0xa6,
+	0xfa, 0x06, 0xcd, 0x3c, 0xc8, 0xe1, 0x71, 0xcd, 0xf0, 0xb8, 0x77, 0x18, 0xa1, 0xe3, 0x0f, 0xe5,
+	0x35, 0x3f, 0xbd, 0x18, 0x0c, 0x63, 0x22, 0x92, 0x72, 0xe6, 0x86, 0x2c, 0x33, 0xc3, 0x63, 0xfe,
+	0xed, 0xf0, 0xe8, 0x81, 0xa7, 0x8a, 0x57, 0x01, 0xfc, 0x87, 0xe3, 0x83, 0xed, 0x0b, 0x29, 0x8e,
+	0x51, 0xb8, 0x08, 0xe4, 0xf8, 0x71, 0xcd, 0xaf, 0x7e, 0x2b, 0xec, 0x03, 0x50, 0xe0, 0x90, 0xe4,
+	0x04, 0x53, 0x21, 0x35, 0x93, 0x6d, 0xa8, 0x21, 0xce, 0x41, 0x03, 0x74, 0x26, 0xd5, 0x79, 0xc2,
+	0xe8, 0xb4, 0x60, 0x71, 0x81, 0x39, 0x97, 0xb2, 0xe5, 0x68, 0x41, 0x68, 0xac, 0x64, 0x5b, 0xf3,
+	0x8d, 0x05, 0x6f, 0x80, 0xd5, 0xb0, 0x2c, 0x38, 0x2b, 0x94, 0x70, 0xeb, 0xe3, 0xee, 0xb3, 0xc7,
+	0x3b, 0x1d, 0x53, 0xd3, 0xed, 0x28, 0x92, 0xb1, 0x9f, 0x8a, 0x82, 0xd0, 0xd8, 0x37, 0xe7, 0xe0,
+	0x3e, 0x68, 0x0b, 0x26, 0x50, 0x1a, 0x24, 0x2c, 0x8d, 0x08, 0x8d, 0xb9, 0x12, 0x6b, 0x7d, 0x7c,
+	0x43, 0xd6, 0xfb, 0xc7, 0xf3, 0xc1, 0x25, 0x1d, 0xcd, 0xa3, 0x07, 0x2e, 0x61, 0x5e, 0x86, 0x44,
+	0xe2, 0xee, 0x51, 0xf1, 0xec, 0xf1, 0x0e, 0x30, 0x69, 0xf7, 0xa8, 0xd0, 0x95, 0x6d, 0xa8, 0x3c,
+	0xbb, 0x26, 0x0d, 0x2c, 0x81, 0x9d, 0x33, 0x96, 0xfe, 0x77, 0xca, 0xaa, 0xeb, 0x9c, 0x6f, 0x2c,
+	0x70, 0xde, 0x70, 0x80, 0x37, 0xc1, 0x79, 0xa4, 0x8b, 0xee, 0x5a, 0xff, 0x20, 0x47, 0x75, 0x10,
+	0xee, 0x82, 0x55, 0x94, 0xb1, 0x92, 0x8a, 0x6e, 0xe3, 0x8c, 0x3a, 0x98, 0x78, 0xe7, 0x57, 0x0b,
+	0x5c, 0xb9, 0x3b, 0xc7, 0x54, 0xd4, 0xbe, 0xf7, 0x7d, 0x3d, 0x62, 0xf0, 0x6d, 0xd0, 0x96, 0x8b,
+	0x2a, 0x28, 0x70, 0x86, 0x08, 0xad, 0xfa, 0x68, 0xfb, 0x1b, 0x12, 0xf5, 0x2b, 0x10, 0x5e, 0x03,
+	0xeb, 0x22, 0x29, 0x30, 0x97, 0xbd, 0x51, 0x7c, 0x6c, 0xff, 0x14, 0x80, 0x1d, 0x70, 0xae, 0x3e,
+	0xbf, 0xda, 0x80, 0x77, 0xc1, 0xda, 0xc9, 0x82, 0xb3, 0x5f, 0x77, 0x7a, 0x4e, 0x42, 0x9d, 0x5f,
+	0x2c, 0xd0, 0x5f, 0x66, 0x3f, 0x79, 0x79, 0xf2, 0xcf, 0xb8, 0xce, 0xea, 0x04, 0x1b, 0x67, 0x26,
+	0xa8, 0x36, 0x07, 0x4a, 0xe5, 0xec, 0xea, 0x35, 0x6e, 0x2c, 0xe7, 0x2b, 0x70, 0x49, 0xf1, 0xae,
+	0x6e, 0x3c, 0x59, 0x7b, 0x03, 0xd0, 0x7a, 0x95, 0x22, 0xc8, 0xff, 0x6d, 0x62, 0xce, 0x08, 0xb4,
+	0x6b, 0xc2, 0xcd, 0x71, 0xb4, 0xbc, 0x8d, 0xac, 0xe5, 0x6d, 0xe4, 0xfc, 0x66, 0x81, 0x37, 0x55,
+	0xcc, 0x1d, 0x96, 0x65, 0x25, 0x95, 0x0f, 0x1b, 0x63, 0xe9, 0xa4, 0xb6, 0x29, 0x96, 0xf7, 0x95,
+	0xf5, 0x3f, 0xd8, 0x57, 0x8d, 0xe5, 0x7d, 0x35, 0xbe, 0xf7, 0xe4, 0xb0, 0x6f, 0x3d, 0x3d, 0xec,
+	0x5b, 0x7f, 0x1e, 0xf6, 0xad, 0x47, 0x47, 0xfd, 0x95, 0xa7, 0x47, 0xfd, 0x95, 0xdf, 0x8f, 0xfa,
+	0x2b, 0x9f, 0xdf, 0xaa, 0xd1, 0x30, 0x0f, 0xe6, 0x4e, 0x81, 0x39, 0x46, 0x45, 0x98, 0x78, 0x61,
+	0x82, 0xc8, 0xf2, 0xfb, 0xa9, 0x98, 0xcd, 0x56, 0x95, 0xf0, 0xef, 0xfd, 0x3d, 0x00, 0xbd, 0xb2,
+	0x89, 0x9f, 0x2a, 0x09, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExemptMsgTypes) > 0 {
+		for iNdEx := len(m.ExemptMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptMsgTypes[iNdEx])
+			copy(dAtA[i:], m.ExemptMsgTypes[iNdEx])
+			i = encodeVarintDissolution(dAtA, i, uint64(len(m.ExemptMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.HaltOnDeadline {
+		i--
+		if m.HaltOnDeadline {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.WarningDays) > 0 {
+		dAtA2 := make([]byte, len(m.WarningDays)*10)
+		var j1 int
+		for _, num := range m.WarningDays {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintDissolution(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.InactivityPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.InactivityPeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintDissolution(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Watchdog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Watchdog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Watchdog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisabledMsgTypes) > 0 {
+		for iNdEx := len(m.DisabledMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledMsgTypes[iNdEx])
+			copy(dAtA[i:], m.DisabledMsgTypes[iNdEx])
+			i = encodeVarintDissolution(dAtA, i, uint64(len(m.DisabledMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DeadlinePassed {
+		i--
+		if m.DeadlinePassed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WarningLevel != 0 {
+		i = encodeVarintDissolution(dAtA, i, uint64(m.WarningLevel))
+		i--
+		dAtA[i] = 0x18
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastActivity, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastActivity):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintDissolution(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.LastPassedProposalId != 0 {
+		i = encodeVarintDissolution(dAtA, i, uint64(m.LastPassedProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Dissolution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Dissolution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Dissolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Recipients != 0 {
+		i = encodeVarintDissolution(dAtA, i, uint64(m.Recipients))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Distributed) > 0 {
+		for iNdEx := len(m.Distributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDissolution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.HaltHeight != 0 {
+		i = encodeVarintDissolution(dAtA, i, uint64(m.HaltHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintDissolution(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintDissolution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDissolution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.TotalHoldings.Size()
+		i -= size
+		if _, err := m.TotalHoldings.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDissolution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintDissolution(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Paying {
+		i--
+		if m.Paying {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Holding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Holding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Holding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDissolution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDissolution(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDissolutionWarning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDissolutionWarning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDissolutionWarning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintDissolution(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if m.Level != 0 {
+		i = encodeVarintDissolution(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintDissolution(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DaysRemaining != 0 {
+		i = encodeVarintDissolution(dAtA, i, uint64(m.DaysRemaining))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDissolutionDeadlinePassed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDissolutionDeadlinePassed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDissolutionDeadlinePassed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintDissolution(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.LastPassedProposalId != 0 {
+		i = encodeVarintDissolution(dAtA, i, uint64(m.LastPassedProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventProposalActivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProposalActivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProposalActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintDissolution(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	if m.ProposalId != 0 {
+		i = encodeVarintDissolution(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDissolved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDissolved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDissolved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HaltHeight != 0 {
+		i = encodeVarintDissolution(dAtA, i, uint64(m.HaltHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCommunityPoolDistributed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCommunityPoolDistributed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCommunityPoolDistributed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Recipients != 0 {
+		i = encodeVarintDissolution(dAtA, i, uint64(m.Recipients))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Distributed) > 0 {
+		for iNdEx := len(m.Distributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDissolution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDissolution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDissolution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.InactivityPeriod)
+	n += 1 + l + sovDissolution(uint64(l))
+	if len(m.WarningDays) > 0 {
+		l = 0
+		for _, e := range m.WarningDays {
+			l += sovDissolution(uint64(e))
+		}
+		n += 1 + sovDissolution(uint64(l)) + l
+	}
+	if m.HaltOnDeadline {
+		n += 2
+	}
+	if len(m.ExemptMsgTypes) > 0 {
+		for _, s := range m.ExemptMsgTypes {
+			l = len(s)
+			n += 1 + l + sovDissolution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Watchdog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastPassedProposalId != 0 {
+		n += 1 + sovDissolution(uint64(m.LastPassedProposalId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastActivity)
+	n += 1 + l + sovDissolution(uint64(l))
+	if m.WarningLevel != 0 {
+		n += 1 + sovDissolution(uint64(m.WarningLevel))
+	}
+	if m.DeadlinePassed {
+		n += 2
+	}
+	if len(m.DisabledMsgTypes) > 0 {
+		for _, s := range m.DisabledMsgTypes {
+			l = len(s)
+			n += 1 + l + sovDissolution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Dissolution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDissolution(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovDissolution(uint64(l))
+	if m.HaltHeight != 0 {
+		n += 1 + sovDissolution(uint64(m.HaltHeight))
+	}
+	if len(m.Distributed) > 0 {
+		for _, e := range m.Distributed {
+			l = e.Size()
+			n += 1 + l + sovDissolution(uint64(l))
+		}
+	}
+	if m.Recipients != 0 {
+		n += 1 + sovDissolution(uint64(m.Recipients))
+	}
+	return n
+}
+
+func (m *DistributionProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paying {
+		n += 2
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovDissolution(uint64(l))
+	}
+	l = m.TotalHoldings.Size()
+	n += 1 + l + sovDissolution(uint64(l))
+	if len(m.Pool) > 0 {
+		for _, e := range m.Pool {
+			l = e.Size()
+			n += 1 + l + sovDissolution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Holding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDissolution(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDissolution(uint64(l))
+	return n
+}
+
+func (m *EventDissolutionWarning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DaysRemaining != 0 {
+		n += 1 + sovDissolution(uint64(m.DaysRemaining))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovDissolution(uint64(m.Threshold))
+	}
+	if m.Level != 0 {
+		n += 1 + sovDissolution(uint64(m.Level))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovDissolution(uint64(l))
+	return n
+}
+
+func (m *EventDissolutionDeadlinePassed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastPassedProposalId != 0 {
+		n += 1 + sovDissolution(uint64(m.LastPassedProposalId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovDissolution(uint64(l))
+	if m.Halted {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventProposalActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovDissolution(uint64(m.ProposalId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovDissolution(uint64(l))
+	return n
+}
+
+func (m *EventDissolved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HaltHeight != 0 {
+		n += 1 + sovDissolution(uint64(m.HaltHeight))
+	}
+	return n
+}
+
+func (m *EventCommunityPoolDistributed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Distributed) > 0 {
+		for _, e := range m.Distributed {
+			l = e.Size()
+			n += 1 + l + sovDissolution(uint64(l))
+		}
+	}
+	if m.Recipients != 0 {
+		n += 1 + sovDissolution(uint64(m.Recipients))
+	}
+	return n
+}
+
+func sovDissolution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDissolution(x uint64) (n int) {
+	return sovDissolution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDissolution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.InactivityPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDissolution
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.WarningDays = append(m.WarningDays, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDissolution
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDissolution
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDissolution
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.WarningDays) == 0 {
+					m.WarningDays = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDissolution
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.WarningDays = append(m.WarningDays, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarningDays", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltOnDeadline", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HaltOnDeadline = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptMsgTypes = append(m.ExemptMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDissolution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Watchdog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDissolution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Watchdog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Watchdog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPassedProposalId", wireType)
+			}
+			m.LastPassedProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPassedProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActivity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastActivity, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarningLevel", wireType)
+			}
+			m.WarningLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WarningLevel |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlinePassed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeadlinePassed = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledMsgTypes = append(m.DisabledMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDissolution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Dissolution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDissolution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Dissolution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Dissolution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltHeight", wireType)
+			}
+			m.HaltHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributed = append(m.Distributed, types.Coin{})
+			if err := m.Distributed[len(m.Distributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			m.Recipients = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Recipients |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDissolution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDissolution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paying", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paying = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalHoldings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalHoldings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = append(m.Pool, types.Coin{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDissolution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Holding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDissolution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Holding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Holding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDissolution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDissolutionWarning) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDissolution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDissolutionWarning: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDissolutionWarning: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaysRemaining", wireType)
+			}
+			m.DaysRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DaysRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDissolution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDissolutionDeadlinePassed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDissolution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDissolutionDeadlinePassed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDissolutionDeadlinePassed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPassedProposalId", wireType)
+			}
+			m.LastPassedProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPassedProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDissolution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventProposalActivity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDissolution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProposalActivity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProposalActivity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDissolution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDissolved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDissolution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDissolved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDissolved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltHeight", wireType)
+			}
+			m.HaltHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDissolution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCommunityPoolDistributed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDissolution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCommunityPoolDistributed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCommunityPoolDistributed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributed = append(m.Distributed, types.Coin{})
+			if err := m.Distributed[len(m.Distributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			m.Recipients = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Recipients |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDissolution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDissolution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDissolution(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDissolution
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDissolution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDissolution
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDissolution
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDissolution
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDissolution        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDissolution          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDissolution = fmt.Errorf("proto: unexpected end of group")
+)
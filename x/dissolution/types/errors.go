@@ -0,0 +1,16 @@
+package types
+
+import (
+	"google.golang.org/grpc/codes"
+
+	"cosmossdk.io/errors"
+)
+
+// x/dissolution module sentinel errors.
+var (
+	ErrInvalidParams      = errors.Register(ModuleName, 2, "invalid dissolution params")
+	ErrInvalidWatchdog    = errors.Register(ModuleName, 3, "invalid dissolution watchdog")
+	ErrInvalidDissolution = errors.Register(ModuleName, 4, "invalid dissolution")
+	ErrAlreadyDissolved   = errors.Register(ModuleName, 5, "the DAO already dissolved")
+	ErrNotDissolved       = errors.RegisterWithGRPCCode(ModuleName, 6, codes.NotFound, "the DAO has not dissolved")
+)
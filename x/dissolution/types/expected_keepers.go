@@ -0,0 +1,68 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// AccountKeeper defines the expected account keeper.
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+}
+
+// AccountStore defines the expected store of accounts, which is the x/auth
+// keeper's Accounts map.
+type AccountStore interface {
+	Iterate(ctx context.Context, ranger collections.Ranger[sdk.AccAddress]) (collections.Iterator[sdk.AccAddress, sdk.AccountI], error)
+}
+
+// BankKeeper defines the expected bank keeper.
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// StakingKeeper defines the expected staking keeper.
+type StakingKeeper interface {
+	ValidatorAddressCodec() address.Codec
+	BondDenom(ctx context.Context) (string, error)
+	GetDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress, maxRetrieve uint16) ([]stakingtypes.Delegation, error)
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+}
+
+// DistributionKeeper defines the expected distribution keeper.
+type DistributionKeeper interface {
+	DistributeFromFeePool(ctx context.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+}
+
+// FeePoolStore defines the expected store of the community pool, which is the
+// x/distribution keeper's FeePool item.
+type FeePoolStore interface {
+	Get(ctx context.Context) (distrtypes.FeePool, error)
+}
+
+// UpgradeKeeper defines the expected upgrade keeper.
+type UpgradeKeeper interface {
+	ScheduleUpgrade(ctx context.Context, plan upgradetypes.Plan) error
+}
+
+// ProposalStore defines the expected store of governance proposals, which is
+// the x/gov keeper's Proposals map.
+type ProposalStore interface {
+	Get(ctx context.Context, proposalID uint64) (govv1.Proposal, error)
+}
+
+// CircuitDisableList defines the expected list of message types the circuit
+// breaker disables, which is the x/circuit keeper's DisableList.
+type CircuitDisableList interface {
+	Has(ctx context.Context, msgTypeURL string) (bool, error)
+	Set(ctx context.Context, msgTypeURL string) error
+	Remove(ctx context.Context, msgTypeURL string) error
+}
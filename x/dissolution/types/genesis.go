@@ -0,0 +1,59 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, watchdog Watchdog) *GenesisState {
+	return &GenesisState{
+		Params:   params,
+		Watchdog: watchdog,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state, which starts the
+// inactivity period at genesis.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), Watchdog{})
+}
+
+// Validate performs a basic validation of the genesis state.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.Watchdog.Validate(); err != nil {
+		return err
+	}
+
+	if gs.Dissolution != nil {
+		if err := gs.Dissolution.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if gs.DistributionProgress != nil {
+		if gs.Dissolution == nil {
+			return errors.Wrap(ErrInvalidDissolution, "distribution progress without a dissolution")
+		}
+		if err := gs.DistributionProgress.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if len(gs.Holdings) > 0 && gs.DistributionProgress == nil {
+		return errors.Wrap(ErrInvalidDissolution, "holdings without a distribution in progress")
+	}
+	holders := make(map[string]bool, len(gs.Holdings))
+	for _, holding := range gs.Holdings {
+		if err := holding.Validate(); err != nil {
+			return err
+		}
+		if holders[holding.Address] {
+			return errors.Wrapf(ErrInvalidDissolution, "duplicate holding of %s", holding.Address)
+		}
+		holders[holding.Address] = true
+	}
+
+	return nil
+}
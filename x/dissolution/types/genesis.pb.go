@@ -0,0 +1,576 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: unicorn/dissolution/v1/genesis.proto
+
+package types
+
+import (
+	_ "cosmossdk.io/api/amino"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the dissolution module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// watchdog is the state of the inactivity watchdog. A zero last activity
+	// starts the inactivity period at genesis.
+	Watchdog Watchdog `protobuf:"bytes,2,opt,name=watchdog,proto3" json:"watchdog"`
+	// dissolution is set once the DAO dissolved by member vote, and the chain
+	// is winding down.
+	Dissolution *Dissolution `protobuf:"bytes,3,opt,name=dissolution,proto3" json:"dissolution,omitempty"`
+	// distribution_progress is set while the community pool is distributed
+	// after the dissolution.
+	DistributionProgress *DistributionProgress `protobuf:"bytes,4,opt,name=distribution_progress,json=distributionProgress,proto3" json:"distribution_progress,omitempty"`
+	// holdings are the holdings tallied by the distribution and not paid yet.
+	Holdings []Holding `protobuf:"bytes,5,rep,name=holdings,proto3" json:"holdings"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42d0fe14164c04d6, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetWatchdog() Watchdog {
+	if m != nil {
+		return m.Watchdog
+	}
+	return Watchdog{}
+}
+
+func (m *GenesisState) GetDissolution() *Dissolution {
+	if m != nil {
+		return m.Dissolution
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionProgress() *DistributionProgress {
+	if m != nil {
+		return m.DistributionProgress
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHoldings() []Holding {
+	if m != nil {
+		return m.Holdings
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "unicorn.dissolution.v1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("unicorn/dissolution/v1/genesis.proto", fileDescriptor_42d0fe14164c04d6)
+}
+
+var fileDescriptor_42d0fe14164c04d6 = []byte{
+	// 340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4e, 0xc2, 0x30,
+	0x18, 0xc7, 0x37, 0x51, 0x82, 0xc5, 0x8b, 0x0b, 0x9a, 0x85, 0x43, 0x21, 0xea, 0x81, 0x18, 0x5d,
+	0x03, 0xc6, 0x07, 0x90, 0xa8, 0x78, 0x93, 0xe0, 0xc1, 0xc4, 0x8b, 0x29, 0x5b, 0xd3, 0x35, 0x81,
+	0x76, 0x69, 0x0b, 0xea, 0x3b, 0x78, 0xf0, 0x31, 0x3c, 0xfa, 0x18, 0x1c, 0x39, 0x7a, 0x32, 0x06,
+	0x0e, 0xbe, 0x86, 0xb1, 0xab, 0x38, 0x12, 0xe6, 0x65, 0xf9, 0xbe, 0x2f, 0xbf, 0xff, 0xef, 0x5b,
+	0xf3, 0x81, 0x83, 0x11, 0x67, 0xa1, 0x90, 0x1c, 0x45, 0x4c, 0x29, 0x31, 0x18, 0x69, 0x26, 0x38,
+	0x1a, 0x37, 0x11, 0x25, 0x9c, 0x28, 0xa6, 0x82, 0x44, 0x0a, 0x2d, 0xbc, 0x5d, 0x4b, 0x05, 0x19,
+	0x2a, 0x18, 0x37, 0xab, 0xdb, 0x78, 0xc8, 0xb8, 0x40, 0xe6, 0x9b, 0xa2, 0xd5, 0x0a, 0x15, 0x54,
+	0x98, 0x12, 0xfd, 0x54, 0x76, 0xda, 0xc8, 0x59, 0x93, 0xf5, 0x19, 0x72, 0xef, 0xb9, 0x00, 0xb6,
+	0x3a, 0xe9, 0xf2, 0x1b, 0x8d, 0x35, 0xf1, 0xce, 0x40, 0x31, 0xc1, 0x12, 0x0f, 0x95, 0xef, 0xd6,
+	0xdd, 0x46, 0xb9, 0x05, 0x83, 0xd5, 0x3f, 0x13, 0x74, 0x0d, 0xd5, 0xde, 0x9c, 0x7c, 0xd4, 0x9c,
+	0xd7, 0xaf, 0xb7, 0x43, 0xb7, 0x67, 0x83, 0x5e, 0x07, 0x94, 0x1e, 0xb0, 0x0e, 0xe3, 0x48, 0x50,
+	0x7f, 0xcd, 0x48, 0xea, 0x79, 0x92, 0x5b, 0xcb, 0x65, 0x35, 0x8b, 0xb0, 0x77, 0x01, 0xca, 0x19,
+	0xde, 0x2f, 0x18, 0xd7, 0x7e, 0x9e, 0xeb, 0xfc, 0xaf, 0xed, 0x65, 0x73, 0x1e, 0x06, 0x3b, 0x11,
+	0x53, 0x5a, 0xb2, 0xbe, 0xe9, 0xef, 0x13, 0x29, 0xa8, 0x24, 0x4a, 0xf9, 0xeb, 0x46, 0x78, 0xf4,
+	0x8f, 0x70, 0x11, 0xea, 0xda, 0x4c, 0xaf, 0x12, 0xad, 0x98, 0x7a, 0x97, 0xa0, 0x14, 0x8b, 0x41,
+	0xc4, 0x38, 0x55, 0xfe, 0x46, 0xbd, 0xd0, 0x28, 0xb7, 0x6a, 0x79, 0xd6, 0xab, 0x94, 0x5b, 0x7a,
+	0xf1, 0x6f, 0xb6, 0x7d, 0x3d, 0x99, 0x41, 0x77, 0x3a, 0x83, 0xee, 0xe7, 0x0c, 0xba, 0x2f, 0x73,
+	0xe8, 0x4c, 0xe7, 0xd0, 0x79, 0x9f, 0x43, 0xe7, 0xee, 0x94, 0x32, 0x1d, 0x8f, 0xfa, 0x41, 0x28,
+	0x86, 0xc8, 0x9a, 0x8f, 0x25, 0x51, 0x04, 0xcb, 0x30, 0x46, 0x61, 0x8c, 0x19, 0x47, 0x8f, 0x4b,
+	0xe7, 0xd6, 0x4f, 0x09, 0x51, 0xfd, 0xa2, 0x39, 0xf3, 0xc9, 0xf7, 0x00, 0x8d, 0x5a, 0x03, 0x29,
+	0x79, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Holdings) > 0 {
+		for iNdEx := len(m.Holdings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holdings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DistributionProgress != nil {
+		{
+			size, err := m.DistributionProgress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Dissolution != nil {
+		{
+			size, err := m.Dissolution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Watchdog.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Watchdog.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Dissolution != nil {
+		l = m.Dissolution.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.DistributionProgress != nil {
+		l = m.DistributionProgress.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Holdings) > 0 {
+		for _, e := range m.Holdings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Watchdog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Watchdog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dissolution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dissolution == nil {
+				m.Dissolution = &Dissolution{}
+			}
+			if err := m.Dissolution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProgress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DistributionProgress == nil {
+				m.DistributionProgress = &DistributionProgress{}
+			}
+			if err := m.DistributionProgress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holdings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holdings = append(m.Holdings, Holding{})
+			if err := m.Holdings[len(m.Holdings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/unicorn-research/chain/x/dissolution/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	t.Parallel()
+
+	lastActivity := time.Unix(1_700_000_000, 0).UTC()
+
+	tests := []struct {
+		name     string
+		genState *types.GenesisState
+		expErr   error
+	}{
+		{
+			name:     "default",
+			genState: types.DefaultGenesisState(),
+		},
+		{
+			name: "halted",
+			genState: types.NewGenesisState(types.DefaultParams(), types.Watchdog{
+				LastPassedProposalId: 4,
+				LastActivity:         lastActivity,
+				WarningLevel:         4,
+				DeadlinePassed:       true,
+				DisabledMsgTypes:     []string{"/cosmos.bank.v1beta1.MsgSend"},
+			}),
+		},
+		{
+			name: "dissolved",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Watchdog:    types.Watchdog{LastActivity: lastActivity},
+				Dissolution: &types.Dissolution{Height: 10, Time: lastActivity, HaltHeight: 20},
+			},
+		},
+		{
+			name: "halt before the dissolution",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Watchdog:    types.Watchdog{LastActivity: lastActivity},
+				Dissolution: &types.Dissolution{Height: 10, Time: lastActivity, HaltHeight: 10},
+			},
+			expErr: types.ErrInvalidDissolution,
+		},
+		{
+			name:     "invalid params",
+			genState: types.NewGenesisState(types.NewParams(0, nil, false, nil), types.Watchdog{}),
+			expErr:   types.ErrInvalidParams,
+		},
+		{
+			name: "disabled before the deadline",
+			genState: types.NewGenesisState(types.DefaultParams(), types.Watchdog{
+				LastActivity:     lastActivity,
+				DisabledMsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"},
+			}),
+			expErr: types.ErrInvalidWatchdog,
+		},
+		{
+			name: "disabled message type not a type URL",
+			genState: types.NewGenesisState(types.DefaultParams(), types.Watchdog{
+				LastActivity:     lastActivity,
+				DeadlinePassed:   true,
+				DisabledMsgTypes: []string{"cosmos.bank.v1beta1.MsgSend"},
+			}),
+			expErr: types.ErrInvalidWatchdog,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.genState.Validate()
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "dissolution"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// HaltUpgradeName is the name of the x/upgrade plan halting the chain once
+	// it wound down. No binary handles it, so the chain stays halted.
+	HaltUpgradeName = "dissolution"
+)
+
+var (
+	// ParamsKey is the key of the module parameters.
+	ParamsKey = collections.NewPrefix(0)
+	// WatchdogKey is the key of the inactivity watchdog state.
+	WatchdogKey = collections.NewPrefix(1)
+	// DissolutionKey is the key of the dissolution by member vote.
+	DissolutionKey = collections.NewPrefix(2)
+	// DistributionProgressKey is the key of the progress of the distribution
+	// of the community pool.
+	DistributionProgressKey = collections.NewPrefix(3)
+	// HoldingsKey is the prefix of the holdings tallied by the distribution.
+	HoldingsKey = collections.NewPrefix(4)
+)
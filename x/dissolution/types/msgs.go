@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgDissolve{}
+)
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// NewMsgDissolve creates a new MsgDissolve instance.
+func NewMsgDissolve(authority string, haltHeight int64) *MsgDissolve {
+	return &MsgDissolve{
+		Authority:  authority,
+		HaltHeight: haltHeight,
+	}
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	circuittypes "github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// Day is the length of a day the deadline is counted in.
+const Day = 24 * time.Hour
+
+// DefaultInactivityPeriod is one year, after which W.S. 17-31-114(a)(iv)
+// dissolves a DAO that approved no proposal.
+const DefaultInactivityPeriod = 365 * Day
+
+// DefaultWarningDays are the default warning thresholds, in days before the
+// deadline.
+var DefaultWarningDays = []uint64{90, 30, 7, 1}
+
+// DefaultExemptMsgTypes are the governance messages that stay enabled when the
+// chain halts, so that members can still submit, fund and vote on proposals,
+// and the circuit breaker reset.
+func DefaultExemptMsgTypes() []string {
+	return []string{
+		sdk.MsgTypeURL(&govv1.MsgSubmitProposal{}),
+		sdk.MsgTypeURL(&govv1.MsgDeposit{}),
+		sdk.MsgTypeURL(&govv1.MsgVote{}),
+		sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}),
+		sdk.MsgTypeURL(&govv1beta1.MsgSubmitProposal{}),
+		sdk.MsgTypeURL(&govv1beta1.MsgDeposit{}),
+		sdk.MsgTypeURL(&govv1beta1.MsgVote{}),
+		sdk.MsgTypeURL(&govv1beta1.MsgVoteWeighted{}),
+		sdk.MsgTypeURL(&circuittypes.MsgResetCircuitBreaker{}),
+	}
+}
+
+// NewParams creates a new Params instance.
+func NewParams(inactivityPeriod time.Duration, warningDays []uint64, haltOnDeadline bool, exemptMsgTypes []string) Params {
+	return Params{
+		InactivityPeriod: inactivityPeriod,
+		WarningDays:      warningDays,
+		HaltOnDeadline:   haltOnDeadline,
+		ExemptMsgTypes:   exemptMsgTypes,
+	}
+}
+
+// DefaultParams returns the default dissolution parameters, which warn but do
+// not halt the chain.
+func DefaultParams() Params {
+	return NewParams(DefaultInactivityPeriod, DefaultWarningDays, false, DefaultExemptMsgTypes())
+}
+
+// Validate performs a basic validation of the parameters.
+func (p Params) Validate() error {
+	if p.InactivityPeriod < Day {
+		return errors.Wrapf(ErrInvalidParams, "inactivity period %s is shorter than a day", p.InactivityPeriod)
+	}
+
+	for i, days := range p.WarningDays {
+		if days == 0 {
+			return errors.Wrap(ErrInvalidParams, "warning days must be positive")
+		}
+		if i > 0 && days >= p.WarningDays[i-1] {
+			return errors.Wrapf(ErrInvalidParams, "warning days %v are not in decreasing order", p.WarningDays)
+		}
+		if time.Duration(days)*Day >= p.InactivityPeriod {
+			return errors.Wrapf(ErrInvalidParams, "warning at %d days is not within the inactivity period %s", days, p.InactivityPeriod)
+		}
+	}
+
+	for _, msgType := range p.ExemptMsgTypes {
+		if !strings.HasPrefix(msgType, "/") {
+			return errors.Wrapf(ErrInvalidParams, "exempt message type %s is not a type URL", msgType)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/unicorn-research/chain/x/dissolution/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		params types.Params
+		expErr error
+	}{
+		{
+			name:   "default",
+			params: types.DefaultParams(),
+		},
+		{
+			name:   "no warnings",
+			params: types.NewParams(types.Day, nil, true, nil),
+		},
+		{
+			name:   "period shorter than a day",
+			params: types.NewParams(time.Hour, nil, false, nil),
+			expErr: types.ErrInvalidParams,
+		},
+		{
+			name:   "zero warning days",
+			params: types.NewParams(types.DefaultInactivityPeriod, []uint64{30, 0}, false, nil),
+			expErr: types.ErrInvalidParams,
+		},
+		{
+			name:   "warning days not decreasing",
+			params: types.NewParams(types.DefaultInactivityPeriod, []uint64{30, 30}, false, nil),
+			expErr: types.ErrInvalidParams,
+		},
+		{
+			name:   "warning outside the period",
+			params: types.NewParams(30*types.Day, []uint64{30}, false, nil),
+			expErr: types.ErrInvalidParams,
+		},
+		{
+			name:   "exempt message type not a type URL",
+			params: types.NewParams(types.DefaultInactivityPeriod, nil, true, []string{"cosmos.gov.v1.MsgVote"}),
+			expErr: types.ErrInvalidParams,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWatchdogDaysRemaining(t *testing.T) {
+	t.Parallel()
+
+	params := types.DefaultParams()
+	start := time.Unix(1_700_000_000, 0).UTC()
+	watchdog := types.Watchdog{LastActivity: start}
+	deadline := start.Add(types.DefaultInactivityPeriod)
+	require.Equal(t, deadline, watchdog.Deadline(params))
+
+	tests := []struct {
+		now           time.Time
+		daysRemaining uint64
+		level         uint64
+	}{
+		{now: start, daysRemaining: 365, level: 0},
+		{now: deadline.Add(-90 * types.Day), daysRemaining: 90, level: 1},
+		{now: deadline.Add(-30*types.Day - time.Second), daysRemaining: 31, level: 1},
+		{now: deadline.Add(-7 * types.Day), daysRemaining: 7, level: 3},
+		{now: deadline.Add(-time.Second), daysRemaining: 1, level: 4},
+		{now: deadline, daysRemaining: 0, level: 4},
+		{now: deadline.Add(types.Day), daysRemaining: 0, level: 4},
+	}
+
+	for _, tc := range tests {
+		daysRemaining := watchdog.DaysRemaining(params, tc.now)
+		require.Equal(t, tc.daysRemaining, daysRemaining, tc.now)
+		require.Equal(t, tc.level, params.WarningLevel(daysRemaining), tc.now)
+	}
+}
@@ -0,0 +1,1379 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: unicorn/dissolution/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	_ "cosmossdk.io/api/amino"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is the Query/Params request type.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9f13bd48251e412, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the Query/Params response type.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9f13bd48251e412, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryWatchdogRequest is the Query/Watchdog request type.
+type QueryWatchdogRequest struct {
+}
+
+func (m *QueryWatchdogRequest) Reset()         { *m = QueryWatchdogRequest{} }
+func (m *QueryWatchdogRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWatchdogRequest) ProtoMessage()    {}
+func (*QueryWatchdogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9f13bd48251e412, []int{2}
+}
+func (m *QueryWatchdogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWatchdogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWatchdogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWatchdogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWatchdogRequest.Merge(m, src)
+}
+func (m *QueryWatchdogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWatchdogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWatchdogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWatchdogRequest proto.InternalMessageInfo
+
+// QueryWatchdogResponse is the Query/Watchdog response type.
+type QueryWatchdogResponse struct {
+	// watchdog is the state of the inactivity watchdog.
+	Watchdog Watchdog `protobuf:"bytes,1,opt,name=watchdog,proto3" json:"watchdog"`
+	// deadline is when the DAO dissolves unless a proposal passes.
+	Deadline time.Time `protobuf:"bytes,2,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	// days_remaining is the number of days until the deadline, rounded up, and
+	// 0 once it passed.
+	DaysRemaining uint64 `protobuf:"varint,3,opt,name=days_remaining,json=daysRemaining,proto3" json:"days_remaining,omitempty"`
+}
+
+func (m *QueryWatchdogResponse) Reset()         { *m = QueryWatchdogResponse{} }
+func (m *QueryWatchdogResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWatchdogResponse) ProtoMessage()    {}
+func (*QueryWatchdogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9f13bd48251e412, []int{3}
+}
+func (m *QueryWatchdogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWatchdogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWatchdogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWatchdogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWatchdogResponse.Merge(m, src)
+}
+func (m *QueryWatchdogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWatchdogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWatchdogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWatchdogResponse proto.InternalMessageInfo
+
+func (m *QueryWatchdogResponse) GetWatchdog() Watchdog {
+	if m != nil {
+		return m.Watchdog
+	}
+	return Watchdog{}
+}
+
+func (m *QueryWatchdogResponse) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return time.Time{}
+}
+
+func (m *QueryWatchdogResponse) GetDaysRemaining() uint64 {
+	if m != nil {
+		return m.DaysRemaining
+	}
+	return 0
+}
+
+// QueryDissolutionRequest is the Query/Dissolution request type.
+type QueryDissolutionRequest struct {
+}
+
+func (m *QueryDissolutionRequest) Reset()         { *m = QueryDissolutionRequest{} }
+func (m *QueryDissolutionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDissolutionRequest) ProtoMessage()    {}
+func (*QueryDissolutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9f13bd48251e412, []int{4}
+}
+func (m *QueryDissolutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDissolutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDissolutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDissolutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDissolutionRequest.Merge(m, src)
+}
+func (m *QueryDissolutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDissolutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDissolutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDissolutionRequest proto.InternalMessageInfo
+
+// QueryDissolutionResponse is the Query/Dissolution response type.
+type QueryDissolutionResponse struct {
+	// dissolution is the dissolution of the DAO.
+	Dissolution Dissolution `protobuf:"bytes,1,opt,name=dissolution,proto3" json:"dissolution"`
+	// distribution_progress is the progress of the distribution of the
+	// community pool, while it runs.
+	DistributionProgress *DistributionProgress `protobuf:"bytes,2,opt,name=distribution_progress,json=distributionProgress,proto3" json:"distribution_progress,omitempty"`
+}
+
+func (m *QueryDissolutionResponse) Reset()         { *m = QueryDissolutionResponse{} }
+func (m *QueryDissolutionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDissolutionResponse) ProtoMessage()    {}
+func (*QueryDissolutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9f13bd48251e412, []int{5}
+}
+func (m *QueryDissolutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDissolutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDissolutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDissolutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDissolutionResponse.Merge(m, src)
+}
+func (m *QueryDissolutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDissolutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDissolutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDissolutionResponse proto.InternalMessageInfo
+
+func (m *QueryDissolutionResponse) GetDissolution() Dissolution {
+	if m != nil {
+		return m.Dissolution
+	}
+	return Dissolution{}
+}
+
+func (m *QueryDissolutionResponse) GetDistributionProgress() *DistributionProgress {
+	if m != nil {
+		return m.DistributionProgress
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "unicorn.dissolution.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "unicorn.dissolution.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryWatchdogRequest)(nil), "unicorn.dissolution.v1.QueryWatchdogRequest")
+	proto.RegisterType((*QueryWatchdogResponse)(nil), "unicorn.dissolution.v1.QueryWatchdogResponse")
+	proto.RegisterType((*QueryDissolutionRequest)(nil), "unicorn.dissolution.v1.QueryDissolutionRequest")
+	proto.RegisterType((*QueryDissolutionResponse)(nil), "unicorn.dissolution.v1.QueryDissolutionResponse")
+}
+
+func init() {
+	proto.RegisterFile("unicorn/dissolution/v1/query.proto", fileDescriptor_c9f13bd48251e412)
+}
+
+var fileDescriptor_c9f13bd48251e412 = []byte{
+	// 556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xae, 0x37, 0x98, 0x8a, 0xab, 0x21, 0x61, 0xba, 0x51, 0x22, 0x94, 0x56, 0x99, 0x86, 0xaa,
+	0xfd, 0x88, 0xd9, 0x10, 0x7f, 0x00, 0x15, 0x88, 0x23, 0xa5, 0x42, 0x02, 0x71, 0x99, 0xdc, 0xc6,
+	0xa4, 0x96, 0x1a, 0x3b, 0xb3, 0x9d, 0x41, 0xaf, 0xdc, 0xb8, 0x0d, 0x71, 0xe6, 0xce, 0x91, 0xff,
+	0x82, 0x9d, 0x60, 0x12, 0x17, 0x4e, 0x80, 0x5a, 0x24, 0xfe, 0x0d, 0x54, 0xc7, 0x29, 0xd9, 0xba,
+	0x94, 0x5e, 0x2a, 0xfb, 0xbd, 0xf7, 0x7d, 0xef, 0xfb, 0x9e, 0x5f, 0x03, 0xbd, 0x84, 0xb3, 0x9e,
+	0x90, 0x1c, 0x07, 0x4c, 0x29, 0x31, 0x48, 0x34, 0x13, 0x1c, 0x1f, 0xed, 0xe1, 0xc3, 0x84, 0xca,
+	0xa1, 0x1f, 0x4b, 0xa1, 0x05, 0x5a, 0xb7, 0x35, 0x7e, 0xae, 0xc6, 0x3f, 0xda, 0x73, 0xae, 0x91,
+	0x88, 0x71, 0x81, 0xcd, 0x6f, 0x5a, 0xea, 0x54, 0x43, 0x11, 0x0a, 0x73, 0xc4, 0x93, 0x93, 0x8d,
+	0xde, 0x0a, 0x85, 0x08, 0x07, 0x14, 0x93, 0x98, 0x61, 0xc2, 0xb9, 0xd0, 0x64, 0x42, 0xa1, 0x6c,
+	0xb6, 0x6e, 0xb3, 0xe6, 0xd6, 0x4d, 0x5e, 0x62, 0xcd, 0x22, 0xaa, 0x34, 0x89, 0x62, 0x5b, 0xd0,
+	0x2c, 0xd0, 0x98, 0x97, 0x63, 0x2a, 0xbd, 0x2a, 0x44, 0x4f, 0x26, 0xc2, 0xdb, 0x44, 0x92, 0x48,
+	0x75, 0xe8, 0x61, 0x42, 0x95, 0xf6, 0x9e, 0xc3, 0xeb, 0x67, 0xa2, 0x2a, 0x16, 0x5c, 0x51, 0x74,
+	0x1f, 0xae, 0xc4, 0x26, 0x52, 0x03, 0x0d, 0xd0, 0xac, 0xec, 0xbb, 0xfe, 0xc5, 0x3e, 0xfd, 0x14,
+	0xd7, 0xba, 0x72, 0xf2, 0xa3, 0x5e, 0xfa, 0xf8, 0xe7, 0xd3, 0x16, 0xe8, 0x58, 0xa0, 0xb7, 0x0e,
+	0xab, 0x86, 0xf9, 0x19, 0xd1, 0xbd, 0x7e, 0x20, 0xc2, 0xac, 0xe3, 0x17, 0x00, 0xd7, 0xce, 0x25,
+	0x6c, 0xd3, 0x47, 0xb0, 0xfc, 0xca, 0xc6, 0x6c, 0xdb, 0x46, 0x51, 0xdb, 0x0c, 0x9b, 0x6f, 0x3c,
+	0x05, 0xa3, 0x87, 0xb0, 0x1c, 0x50, 0x12, 0x0c, 0x18, 0xa7, 0xb5, 0x25, 0x43, 0xe4, 0xf8, 0xe9,
+	0x20, 0xfd, 0x6c, 0x90, 0xfe, 0xd3, 0x6c, 0x90, 0xad, 0xd5, 0x09, 0xc5, 0xf1, 0xcf, 0x3a, 0xb0,
+	0x34, 0x19, 0x14, 0x6d, 0xc2, 0xab, 0x01, 0x19, 0xaa, 0x03, 0x49, 0x23, 0xc2, 0x38, 0xe3, 0x61,
+	0x6d, 0xb9, 0x01, 0x9a, 0x97, 0x3a, 0xab, 0x93, 0x68, 0x27, 0x0b, 0x7a, 0x37, 0xe1, 0x0d, 0xe3,
+	0xe7, 0xc1, 0x3f, 0x89, 0x99, 0xd7, 0xaf, 0x00, 0xd6, 0x66, 0x73, 0xd6, 0x6e, 0x1b, 0x56, 0x72,
+	0xae, 0xac, 0xe3, 0x8d, 0x22, 0xc7, 0x39, 0x86, 0xbc, 0xe9, 0x3c, 0x05, 0x22, 0x70, 0x2d, 0x60,
+	0x4a, 0x4b, 0xd6, 0x35, 0xf7, 0x83, 0x58, 0x8a, 0x50, 0x52, 0xa5, 0xec, 0x10, 0x76, 0xe6, 0x70,
+	0x4f, 0x41, 0x6d, 0x8b, 0xe9, 0x54, 0x83, 0x0b, 0xa2, 0xfb, 0x9f, 0x97, 0xe1, 0x65, 0xe3, 0x08,
+	0xbd, 0x05, 0x70, 0x25, 0x7d, 0x7d, 0xb4, 0x55, 0x44, 0x3c, 0xbb, 0x70, 0xce, 0xf6, 0x42, 0xb5,
+	0xe9, 0x88, 0xbc, 0xdb, 0x6f, 0xbe, 0xfd, 0x7e, 0xbf, 0xd4, 0x40, 0x2e, 0x2e, 0x58, 0xf3, 0x74,
+	0xd7, 0xd0, 0x3b, 0x00, 0xcb, 0xd9, 0x4a, 0xa0, 0x9d, 0xb9, 0x1d, 0xce, 0xad, 0xa3, 0xb3, 0xbb,
+	0x60, 0xb5, 0x55, 0xd4, 0x34, 0x8a, 0x3c, 0xd4, 0x28, 0x52, 0x34, 0x5d, 0xc2, 0x0f, 0x00, 0x56,
+	0x72, 0x8f, 0x86, 0xf0, 0xdc, 0x46, 0xb3, 0xcb, 0xe3, 0xdc, 0x59, 0x1c, 0x60, 0xc5, 0x6d, 0x1b,
+	0x71, 0x9b, 0x68, 0x03, 0xff, 0xff, 0xab, 0xd0, 0x7a, 0x7c, 0x32, 0x72, 0xc1, 0xe9, 0xc8, 0x05,
+	0xbf, 0x46, 0x2e, 0x38, 0x1e, 0xbb, 0xa5, 0xd3, 0xb1, 0x5b, 0xfa, 0x3e, 0x76, 0x4b, 0x2f, 0xee,
+	0x85, 0x4c, 0xf7, 0x93, 0xae, 0xdf, 0x13, 0x51, 0x46, 0xb4, 0x2b, 0xa9, 0xa2, 0x44, 0xf6, 0xfa,
+	0xb8, 0xd7, 0x27, 0x8c, 0xe3, 0xd7, 0x67, 0x98, 0xf5, 0x30, 0xa6, 0xaa, 0xbb, 0x62, 0xfe, 0x5b,
+	0x77, 0xff, 0x0e, 0x00, 0x8f, 0x27, 0xec, 0xd7, 0x37, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Watchdog returns the state of the inactivity watchdog and the days
+	// remaining until the DAO dissolves for inactivity.
+	Watchdog(ctx context.Context, in *QueryWatchdogRequest, opts ...grpc.CallOption) (*QueryWatchdogResponse, error)
+	// Dissolution returns the dissolution of the DAO by member vote, once it
+	// dissolved.
+	Dissolution(ctx context.Context, in *QueryDissolutionRequest, opts ...grpc.CallOption) (*QueryDissolutionResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/unicorn.dissolution.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Watchdog(ctx context.Context, in *QueryWatchdogRequest, opts ...grpc.CallOption) (*QueryWatchdogResponse, error) {
+	out := new(QueryWatchdogResponse)
+	err := c.cc.Invoke(ctx, "/unicorn.dissolution.v1.Query/Watchdog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Dissolution(ctx context.Context, in *QueryDissolutionRequest, opts ...grpc.CallOption) (*QueryDissolutionResponse, error) {
+	out := new(QueryDissolutionResponse)
+	err := c.cc.Invoke(ctx, "/unicorn.dissolution.v1.Query/Dissolution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Watchdog returns the state of the inactivity watchdog and the days
+	// remaining until the DAO dissolves for inactivity.
+	Watchdog(context.Context, *QueryWatchdogRequest) (*QueryWatchdogResponse, error)
+	// Dissolution returns the dissolution of the DAO by member vote, once it
+	// dissolved.
+	Dissolution(context.Context, *QueryDissolutionRequest) (*QueryDissolutionResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Watchdog(ctx context.Context, req *QueryWatchdogRequest) (*QueryWatchdogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Watchdog not implemented")
+}
+func (*UnimplementedQueryServer) Dissolution(ctx context.Context, req *QueryDissolutionRequest) (*QueryDissolutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dissolution not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/unicorn.dissolution.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Watchdog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWatchdogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Watchdog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/unicorn.dissolution.v1.Query/Watchdog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Watchdog(ctx, req.(*QueryWatchdogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Dissolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDissolutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Dissolution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/unicorn.dissolution.v1.Query/Dissolution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Dissolution(ctx, req.(*QueryDissolutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "unicorn.dissolution.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Watchdog",
+			Handler:    _Query_Watchdog_Handler,
+		},
+		{
+			MethodName: "Dissolution",
+			Handler:    _Query_Dissolution_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "unicorn/dissolution/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWatchdogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWatchdogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWatchdogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWatchdogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWatchdogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWatchdogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DaysRemaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DaysRemaining))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Watchdog.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDissolutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDissolutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDissolutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDissolutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDissolutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDissolutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DistributionProgress != nil {
+		{
+			size, err := m.DistributionProgress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Dissolution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWatchdogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryWatchdogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Watchdog.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DaysRemaining != 0 {
+		n += 1 + sovQuery(uint64(m.DaysRemaining))
+	}
+	return n
+}
+
+func (m *QueryDissolutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDissolutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Dissolution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DistributionProgress != nil {
+		l = m.DistributionProgress.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWatchdogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWatchdogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWatchdogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWatchdogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWatchdogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWatchdogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Watchdog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Watchdog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaysRemaining", wireType)
+			}
+			m.DaysRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DaysRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDissolutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDissolutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDissolutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDissolutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDissolutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDissolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dissolution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Dissolution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProgress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DistributionProgress == nil {
+				m.DistributionProgress = &DistributionProgress{}
+			}
+			if err := m.DistributionProgress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	"cosmossdk.io/errors"
+)
+
+// Deadline returns when the DAO dissolves unless a proposal passes.
+func (w Watchdog) Deadline(params Params) time.Time {
+	return w.LastActivity.Add(params.InactivityPeriod)
+}
+
+// DaysRemaining returns the number of days from now until the deadline,
+// rounded up, and 0 once it passed.
+func (w Watchdog) DaysRemaining(params Params, now time.Time) uint64 {
+	remaining := w.Deadline(params).Sub(now)
+	if remaining <= 0 {
+		return 0
+	}
+
+	return uint64((remaining + Day - 1) / Day)
+}
+
+// Validate performs a basic validation of the watchdog state.
+func (w Watchdog) Validate() error {
+	if len(w.DisabledMsgTypes) > 0 && !w.DeadlinePassed {
+		return errors.Wrap(ErrInvalidWatchdog, "message types are disabled before the deadline passed")
+	}
+
+	for _, msgType := range w.DisabledMsgTypes {
+		if !strings.HasPrefix(msgType, "/") {
+			return errors.Wrapf(ErrInvalidWatchdog, "disabled message type %s is not a type URL", msgType)
+		}
+	}
+
+	return nil
+}
+
+// WarningLevel returns the number of warning thresholds crossed with the
+// given number of days remaining.
+func (p Params) WarningLevel(daysRemaining uint64) uint64 {
+	var level uint64
+	for _, days := range p.WarningDays {
+		if daysRemaining <= days {
+			level++
+		}
+	}
+
+	return level
+}
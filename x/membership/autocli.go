@@ -0,0 +1,55 @@
+package membership
+
+import (
+	"github.com/unicorn-research/chain/x/membership/types"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the membership parameters",
+				},
+				{
+					RpcMethod:      "Member",
+					Use:            "member [address]",
+					Short:          "Query the membership interest of a member, by contribution and per member",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "Members",
+					Use:       "members",
+					Short:     "Query the membership interests of the members, by contribution and per member",
+				},
+				{
+					RpcMethod: "Totals",
+					Use:       "totals",
+					Short:     "Query the total contributions to the DAO treasury and the number of members",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "Contribute",
+					Use:            "contribute [member] [amount]",
+					Short:          "Contribute digital assets to the DAO treasury, becoming a member",
+					Example:        "contribute alice 1000000stake",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "member"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
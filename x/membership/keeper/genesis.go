@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/unicorn-research/chain/x/membership/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the membership module's state from a provided
+// genesis state. The totals are derived from the members, and an empty
+// contribution denom is set to the bond denom, so x/staking has to be
+// initialized first.
+func (k Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState) error {
+	params := genState.Params
+	if params.ContributionDenom == "" {
+		bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+		if err != nil {
+			return err
+		}
+		params.ContributionDenom = bondDenom
+	}
+	if err := k.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	total := math.ZeroInt()
+	for _, member := range genState.Members {
+		address, err := k.ak.AddressCodec().StringToBytes(member.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.Members.Set(ctx, address, member); err != nil {
+			return err
+		}
+		total = total.Add(member.Contribution)
+	}
+
+	if err := k.TotalContributions.Set(ctx, total); err != nil {
+		return err
+	}
+
+	return k.MemberCount.Set(ctx, uint64(len(genState.Members)))
+}
+
+// ExportGenesis returns the membership module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []types.Member
+	err = k.Members.Walk(ctx, nil, func(_ sdk.AccAddress, member types.Member) (bool, error) {
+		members = append(members, member)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, members), nil
+}
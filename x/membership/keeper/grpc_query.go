@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/unicorn-research/chain/x/membership/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = queryServer{}
+
+type queryServer struct {
+	k Keeper
+}
+
+// NewQueryServerImpl returns an implementation of the membership QueryServer
+// interface for the provided Keeper.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k: k}
+}
+
+// Params returns the membership parameters.
+func (q queryServer) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Member returns the membership interest of a member in both calculation
+// modes.
+func (q queryServer) Member(ctx context.Context, req *types.QueryMemberRequest) (*types.QueryMemberResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := q.k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	interest, err := q.k.GetMemberInterest(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMemberResponse{Interest: interest}, nil
+}
+
+// Members returns the membership interests of the members in both
+// calculation modes.
+func (q queryServer) Members(ctx context.Context, req *types.QueryMembersRequest) (*types.QueryMembersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	total, count, err := q.k.Totals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	interests, pageRes, err := query.CollectionPaginate(ctx, q.k.Members, req.Pagination, func(_ sdk.AccAddress, member types.Member) (types.MemberInterest, error) {
+		return types.NewMemberInterest(member, total, count), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMembersResponse{Interests: interests, Pagination: pageRes}, nil
+}
+
+// Totals returns the total contributions and the number of members.
+func (q queryServer) Totals(ctx context.Context, req *types.QueryTotalsRequest) (*types.QueryTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	total, count, err := q.k.Totals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTotalsResponse{Contributions: total, Members: count}, nil
+}
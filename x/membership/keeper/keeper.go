@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/unicorn-research/chain/x/membership/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the membership store.
+type Keeper struct {
+	ak            types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
+
+	// the address capable of executing a MsgUpdateParams message, usually the gov module account
+	authority string
+
+	Schema             collections.Schema
+	Params             collections.Item[types.Params]
+	Members            collections.Map[sdk.AccAddress, types.Member]
+	TotalContributions collections.Item[math.Int]
+	MemberCount        collections.Item[uint64]
+}
+
+// NewKeeper constructs a new membership keeper. The contributions are held by
+// the module's account, the DAO treasury.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sk types.StakingKeeper,
+	authority string,
+) Keeper {
+	if _, err := ak.AddressCodec().StringToBytes(authority); err != nil {
+		panic(fmt.Errorf("invalid authority address: %w", err))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		ak:                 ak,
+		bankKeeper:         bk,
+		stakingKeeper:      sk,
+		authority:          authority,
+		Params:             collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Members:            collections.NewMap(sb, types.MembersKey, "members", sdk.AccAddressKey, codec.CollValue[types.Member](cdc)),
+		TotalContributions: collections.NewItem(sb, types.TotalContributionsKey, "total_contributions", sdk.IntValue),
+		MemberCount:        collections.NewItem(sb, types.MemberCountKey, "member_count", collections.Uint64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the membership module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Contribute deposits a contribution into the DAO treasury, making the
+// contributor a member on its first contribution.
+func (k Keeper) Contribute(ctx context.Context, contributor sdk.AccAddress, amount sdk.Coin) (types.Member, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.Member{}, err
+	}
+	if amount.Denom != params.ContributionDenom {
+		return types.Member{}, errorsmod.Wrapf(types.ErrInvalidContribution, "contributions are in %s, got %s", params.ContributionDenom, amount.Denom)
+	}
+	if !amount.IsValid() || !amount.IsPositive() {
+		return types.Member{}, errorsmod.Wrapf(types.ErrInvalidContribution, "invalid amount %s", amount)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, contributor, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return types.Member{}, err
+	}
+
+	address, err := k.ak.AddressCodec().BytesToString(contributor)
+	if err != nil {
+		return types.Member{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	member, err := k.Members.Get(ctx, contributor)
+	newMember := errors.Is(err, collections.ErrNotFound)
+	switch {
+	case newMember:
+		member = types.Member{
+			Address:      address,
+			Contribution: math.ZeroInt(),
+			JoinedHeight: sdkCtx.BlockHeight(),
+			JoinedTime:   sdkCtx.BlockTime(),
+		}
+	case err != nil:
+		return types.Member{}, err
+	}
+
+	member.Contribution = member.Contribution.Add(amount.Amount)
+	if err := k.Members.Set(ctx, contributor, member); err != nil {
+		return types.Member{}, err
+	}
+
+	total, err := k.TotalContributions.Get(ctx)
+	if err != nil {
+		return types.Member{}, err
+	}
+	if err := k.TotalContributions.Set(ctx, total.Add(amount.Amount)); err != nil {
+		return types.Member{}, err
+	}
+
+	if newMember {
+		count, err := k.MemberCount.Get(ctx)
+		if err != nil {
+			return types.Member{}, err
+		}
+		if err := k.MemberCount.Set(ctx, count+1); err != nil {
+			return types.Member{}, err
+		}
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventContribution{
+		Member:       member.Address,
+		Amount:       amount,
+		Contribution: member.Contribution,
+		NewMember:    newMember,
+	}); err != nil {
+		return types.Member{}, err
+	}
+
+	return member, nil
+}
+
+// Totals returns the total contributions and the number of members.
+func (k Keeper) Totals(ctx context.Context) (math.Int, uint64, error) {
+	total, err := k.TotalContributions.Get(ctx)
+	if err != nil {
+		return math.Int{}, 0, err
+	}
+
+	count, err := k.MemberCount.Get(ctx)
+	if err != nil {
+		return math.Int{}, 0, err
+	}
+
+	return total, count, nil
+}
+
+// GetMemberInterest returns the membership interest of a member in both
+// calculation modes, or ErrNotMember when the address is not a member's.
+func (k Keeper) GetMemberInterest(ctx context.Context, address sdk.AccAddress) (types.MemberInterest, error) {
+	member, err := k.Members.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.MemberInterest{}, errorsmod.Wrap(types.ErrNotMember, address.String())
+	}
+	if err != nil {
+		return types.MemberInterest{}, err
+	}
+
+	total, count, err := k.Totals(ctx)
+	if err != nil {
+		return types.MemberInterest{}, err
+	}
+
+	return types.NewMemberInterest(member, total, count), nil
+}
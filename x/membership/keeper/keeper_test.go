@@ -0,0 +1,219 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/unicorn-research/chain/x/membership"
+	"github.com/unicorn-research/chain/x/membership/keeper"
+	"github.com/unicorn-research/chain/x/membership/types"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type mockAccountKeeper struct{}
+
+func (mockAccountKeeper) AddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec("cosmos")
+}
+
+func (mockAccountKeeper) GetAccount(context.Context, sdk.AccAddress) sdk.AccountI {
+	return nil
+}
+
+func (mockAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+func (mockAccountKeeper) GetModuleAccount(context.Context, string) sdk.ModuleAccountI {
+	return nil
+}
+
+func (mockAccountKeeper) SetModuleAccount(context.Context, sdk.ModuleAccountI) {}
+
+type mockBankKeeper struct {
+	treasury sdk.Coins
+}
+
+func (bk *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, _ sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if recipientModule != types.ModuleName {
+		return fmt.Errorf("unexpected module %s", recipientModule)
+	}
+
+	bk.treasury = bk.treasury.Add(amt...)
+	return nil
+}
+
+type mockStakingKeeper struct {
+	totalBonded math.Int
+	validators  []stakingtypes.Validator
+	delegations map[string][]stakingtypes.Delegation
+}
+
+func (*mockStakingKeeper) BondDenom(context.Context) (string, error) {
+	return sdk.DefaultBondDenom, nil
+}
+
+func (*mockStakingKeeper) ValidatorAddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec("cosmosvaloper")
+}
+
+func (sk *mockStakingKeeper) IterateBondedValidatorsByPower(_ context.Context, fn func(index int64, validator stakingtypes.ValidatorI) bool) error {
+	for i, validator := range sk.validators {
+		if fn(int64(i), validator) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (sk *mockStakingKeeper) TotalBondedTokens(context.Context) (math.Int, error) {
+	return sk.totalBonded, nil
+}
+
+func (sk *mockStakingKeeper) IterateDelegations(_ context.Context, delegator sdk.AccAddress, fn func(index int64, delegation stakingtypes.DelegationI) bool) error {
+	for i, delegation := range sk.delegations[string(delegator)] {
+		if fn(int64(i), delegation) {
+			break
+		}
+	}
+
+	return nil
+}
+
+type fixture struct {
+	ctx           sdk.Context
+	codec         codec.Codec
+	keeper        keeper.Keeper
+	authority     string
+	bankKeeper    *mockBankKeeper
+	stakingKeeper *mockStakingKeeper
+	govKeeper     *govkeeper.Keeper
+	govKey        *storetypes.KVStoreKey
+}
+
+func setupFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	govKey := storetypes.NewKVStoreKey(govtypes.StoreKey)
+	keys := map[string]*storetypes.KVStoreKey{types.StoreKey: key, govtypes.StoreKey: govKey}
+	transientKeys := map[string]*storetypes.TransientStoreKey{"transient_test": storetypes.NewTransientStoreKey("transient_test")}
+	ctx := testutil.DefaultContextWithKeys(keys, transientKeys, nil)
+	encCfg := moduletestutil.MakeTestEncodingConfig(membership.AppModuleBasic{})
+
+	ak := mockAccountKeeper{}
+	authority, err := ak.AddressCodec().BytesToString(authtypes.NewModuleAddress(govtypes.ModuleName))
+	require.NoError(t, err)
+
+	bk := &mockBankKeeper{}
+	sk := &mockStakingKeeper{totalBonded: math.NewInt(1000), delegations: make(map[string][]stakingtypes.Delegation)}
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), ak, bk, sk, authority)
+
+	// The votes are tallied from the store of a x/gov keeper, which is only
+	// used for its votes and params
+	govKeeper := govkeeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(govKey), ak, nil, sk, nil, nil, govtypes.DefaultConfig(), authority)
+
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+	require.NoError(t, govKeeper.Params.Set(ctx, govv1.DefaultParams()))
+	require.NoError(t, k.InitGenesis(ctx, types.DefaultGenesisState()))
+
+	return &fixture{ctx: ctx, codec: encCfg.Codec, keeper: k, authority: authority, bankKeeper: bk, stakingKeeper: sk, govKeeper: govKeeper, govKey: govKey}
+}
+
+func TestContribute(t *testing.T) {
+	t.Parallel()
+
+	f := setupFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+
+	_, err := queryServer.Member(f.ctx, &types.QueryMemberRequest{Address: alice.String()})
+	require.ErrorIs(t, err, types.ErrNotMember)
+
+	// Contributions are in the contribution denom
+	_, err = msgServer.Contribute(f.ctx, types.NewMsgContribute(alice.String(), sdk.NewInt64Coin("uatom", 100)))
+	require.ErrorIs(t, err, types.ErrInvalidContribution)
+	_, err = msgServer.Contribute(f.ctx, types.NewMsgContribute(alice.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)))
+	require.ErrorIs(t, err, types.ErrInvalidContribution)
+
+	res, err := msgServer.Contribute(f.ctx, types.NewMsgContribute(alice.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), res.Contribution)
+
+	res, err = msgServer.Contribute(f.ctx.WithBlockHeight(11), types.NewMsgContribute(alice.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(300), res.Contribution)
+
+	_, err = msgServer.Contribute(f.ctx, types.NewMsgContribute(bob.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	require.NoError(t, err)
+
+	// The treasury holds the contributions
+	require.Equal(t, "400stake", f.bankKeeper.treasury.String())
+
+	totals, err := queryServer.Totals(f.ctx, &types.QueryTotalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(400), totals.Contributions)
+	require.Equal(t, uint64(2), totals.Members)
+
+	member, err := queryServer.Member(f.ctx, &types.QueryMemberRequest{Address: alice.String()})
+	require.NoError(t, err)
+	require.Equal(t, alice.String(), member.Interest.Member.Address)
+	require.Equal(t, int64(10), member.Interest.Member.JoinedHeight)
+	require.Equal(t, "0.750000000000000000", member.Interest.ContributionInterest.String())
+	require.Equal(t, "0.500000000000000000", member.Interest.PerMemberInterest.String())
+
+	members, err := queryServer.Members(f.ctx, &types.QueryMembersRequest{Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, members.Interests, 1)
+	require.NotNil(t, members.Pagination.NextKey)
+
+	genState, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Len(t, genState.Members, 2)
+	require.NoError(t, genState.Validate())
+}
+
+func TestUpdateParams(t *testing.T) {
+	t.Parallel()
+
+	f := setupFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	params := types.NewParams("uatom", types.InterestModePerMember, true)
+
+	// Only the authority can update the params
+	_, err := msgServer.UpdateParams(f.ctx, types.NewMsgUpdateParams("cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysn3k7pr0", params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.UpdateParams(f.ctx, types.NewMsgUpdateParams(f.authority, types.NewParams("uatom", types.InterestModeUnspecified, true)))
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+
+	_, err = msgServer.UpdateParams(f.ctx, types.NewMsgUpdateParams(f.authority, params))
+	require.NoError(t, err)
+
+	res, err := keeper.NewQueryServerImpl(f.keeper).Params(f.ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/unicorn-research/chain/x/membership/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the membership MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// Contribute deposits a contribution into the DAO treasury.
+func (k msgServer) Contribute(ctx context.Context, msg *types.MsgContribute) (*types.MsgContributeResponse, error) {
+	contributor, err := k.ak.AddressCodec().StringToBytes(msg.Member)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMember, "invalid member address: %s", err)
+	}
+
+	member, err := k.Keeper.Contribute(ctx, contributor, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgContributeResponse{Contribution: member.Contribution}, nil
+}
+
+// UpdateParams updates the membership parameters.
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
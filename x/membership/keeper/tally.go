@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/unicorn-research/chain/x/membership/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ govkeeper.CalculateVoteResultsAndVotingPowerFn = Keeper{}.CalculateVoteResultsAndVotingPower
+
+// CalculateVoteResultsAndVotingPower tallies the votes of a proposal for
+// x/gov, see Tally.
+func (k Keeper) CalculateVoteResultsAndVotingPower(
+	ctx context.Context,
+	govKeeper govkeeper.Keeper,
+	proposal govv1.Proposal,
+	validators map[string]govv1.ValidatorGovInfo,
+) (math.LegacyDec, map[govv1.VoteOption]math.LegacyDec, error) {
+	return k.Tally(ctx, govKeeper.Votes, proposal.Id, validators)
+}
+
+// Tally tallies the votes on a proposal and removes them. When the params
+// tally by membership, a member's voting power is its membership interest in
+// the params' calculation mode, scaled to the bonded tokens that x/gov
+// measures the quorum against, and other voters have none. Otherwise the
+// votes are tallied by stake, as x/gov does by default.
+func (k Keeper) Tally(
+	ctx context.Context,
+	votes types.VoteStore,
+	proposalID uint64,
+	validators map[string]govv1.ValidatorGovInfo,
+) (math.LegacyDec, map[govv1.VoteOption]math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	results := map[govv1.VoteOption]math.LegacyDec{
+		govv1.OptionYes:        math.LegacyZeroDec(),
+		govv1.OptionAbstain:    math.LegacyZeroDec(),
+		govv1.OptionNo:         math.LegacyZeroDec(),
+		govv1.OptionNoWithVeto: math.LegacyZeroDec(),
+	}
+
+	var totalVotingPower math.LegacyDec
+	if params.TallyByMembership {
+		totalVotingPower, err = k.tallyByMembership(ctx, votes, proposalID, params, results)
+	} else {
+		totalVotingPower, err = k.tallyByStake(ctx, votes, proposalID, validators, results)
+	}
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	return totalVotingPower, results, nil
+}
+
+// tallyByMembership adds the votes of the members to the results, weighted by
+// their membership interests, and returns the total voting power.
+func (k Keeper) tallyByMembership(ctx context.Context, votes types.VoteStore, proposalID uint64, params types.Params, results map[govv1.VoteOption]math.LegacyDec) (math.LegacyDec, error) {
+	totalBonded, err := k.stakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	totalContributions, count, err := k.Totals(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	totalVotingPower := math.LegacyZeroDec()
+	err = walkVotes(ctx, votes, proposalID, func(voter sdk.AccAddress, options govv1.WeightedVoteOptions) error {
+		member, err := k.Members.Get(ctx, voter)
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		interest := types.NewMemberInterest(member, totalContributions, count).Interest(params)
+		votingPower := interest.MulInt(totalBonded)
+		if err := addVote(results, votingPower, options); err != nil {
+			return err
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+
+		return nil
+	})
+
+	return totalVotingPower, err
+}
+
+// tallyByStake adds the votes to the results weighted by stake: delegators
+// vote with their delegations, and validators with the delegations whose
+// delegators did not vote. It is a copy of the default tally of x/gov, which
+// is not exported, and TestTallyByStakeMatchesGov keeps the two in step.
+func (k Keeper) tallyByStake(ctx context.Context, votes types.VoteStore, proposalID uint64, validators map[string]govv1.ValidatorGovInfo, results map[govv1.VoteOption]math.LegacyDec) (math.LegacyDec, error) {
+	totalVotingPower := math.LegacyZeroDec()
+	err := walkVotes(ctx, votes, proposalID, func(voter sdk.AccAddress, options govv1.WeightedVoteOptions) error {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(voter)
+		if err != nil {
+			return err
+		}
+		if validator, ok := validators[valAddr]; ok {
+			validator.Vote = options
+			validators[valAddr] = validator
+		}
+
+		var voteErr error
+		err = k.stakingKeeper.IterateDelegations(ctx, voter, func(_ int64, delegation stakingtypes.DelegationI) bool {
+			validator, ok := validators[delegation.GetValidatorAddr()]
+			if !ok {
+				return false
+			}
+
+			validator.DelegatorDeductions = validator.DelegatorDeductions.Add(delegation.GetShares())
+			validators[delegation.GetValidatorAddr()] = validator
+
+			votingPower := delegation.GetShares().MulInt(validator.BondedTokens).Quo(validator.DelegatorShares)
+			if voteErr = addVote(results, votingPower, options); voteErr != nil {
+				return true
+			}
+			totalVotingPower = totalVotingPower.Add(votingPower)
+
+			return false
+		})
+		if err != nil {
+			return err
+		}
+
+		return voteErr
+	})
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	for _, validator := range validators {
+		if len(validator.Vote) == 0 {
+			continue
+		}
+
+		sharesAfterDeductions := validator.DelegatorShares.Sub(validator.DelegatorDeductions)
+		votingPower := sharesAfterDeductions.MulInt(validator.BondedTokens).Quo(validator.DelegatorShares)
+		if err := addVote(results, votingPower, validator.Vote); err != nil {
+			return math.LegacyDec{}, err
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+	}
+
+	return totalVotingPower, nil
+}
+
+// walkVotes calls fn with every vote on the proposal, and removes the votes
+// once tallied, as x/gov does.
+func walkVotes(ctx context.Context, votes types.VoteStore, proposalID uint64, fn func(voter sdk.AccAddress, options govv1.WeightedVoteOptions) error) error {
+	var tallied []collections.Pair[uint64, sdk.AccAddress]
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	err := votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote govv1.Vote) (bool, error) {
+		tallied = append(tallied, key)
+		return false, fn(key.K2(), vote.Options)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range tallied {
+		if err := votes.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addVote adds the voting power to the results, split between the options by
+// their weights.
+func addVote(results map[govv1.VoteOption]math.LegacyDec, votingPower math.LegacyDec, options govv1.WeightedVoteOptions) error {
+	for _, option := range options {
+		weight, err := math.LegacyNewDecFromStr(option.Weight)
+		if err != nil {
+			return err
+		}
+
+		results[option.Option] = results[option.Option].Add(votingPower.Mul(weight))
+	}
+
+	return nil
+}
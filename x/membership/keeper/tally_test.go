@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/unicorn-research/chain/x/membership/keeper"
+	"github.com/unicorn-research/chain/x/membership/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTally(t *testing.T) {
+	t.Parallel()
+
+	const proposalID = 1
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	carol := sdk.AccAddress("carol_______________") // not a member
+	validator := sdk.AccAddress("validator___________")
+
+	tests := []struct {
+		name     string
+		params   types.Params
+		expected map[govv1.VoteOption]string
+		total    string
+	}{
+		{
+			name:   "by contribution",
+			params: types.NewParams(sdk.DefaultBondDenom, types.InterestModeContribution, true),
+			expected: map[govv1.VoteOption]string{
+				govv1.OptionYes: "750.000000000000000000",
+				govv1.OptionNo:  "250.000000000000000000",
+			},
+			total: "1000.000000000000000000",
+		},
+		{
+			name:   "per member",
+			params: types.NewParams(sdk.DefaultBondDenom, types.InterestModePerMember, true),
+			expected: map[govv1.VoteOption]string{
+				govv1.OptionYes: "500.000000000000000000",
+				govv1.OptionNo:  "500.000000000000000000",
+			},
+			total: "1000.000000000000000000",
+		},
+		{
+			name:   "by stake",
+			params: types.NewParams(sdk.DefaultBondDenom, types.InterestModeContribution, false),
+			expected: map[govv1.VoteOption]string{
+				govv1.OptionYes: "100.000000000000000000",
+				govv1.OptionNo:  "100.000000000000000000",
+			},
+			total: "200.000000000000000000",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := setupFixture(t)
+			msgServer := keeper.NewMsgServerImpl(f.keeper)
+			_, err := msgServer.UpdateParams(f.ctx, types.NewMsgUpdateParams(f.authority, tc.params))
+			require.NoError(t, err)
+
+			_, err = f.keeper.Contribute(f.ctx, alice, sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+			require.NoError(t, err)
+			_, err = f.keeper.Contribute(f.ctx, bob, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+			require.NoError(t, err)
+
+			// Carol delegates half of the validator's stake and votes with it,
+			// the validator votes with the other half
+			valAddr, err := f.stakingKeeper.ValidatorAddressCodec().BytesToString(validator)
+			require.NoError(t, err)
+			f.stakingKeeper.delegations[string(carol)] = []stakingtypes.Delegation{stakingtypes.NewDelegation(carol.String(), valAddr, math.LegacyNewDec(100))}
+			validators := map[string]govv1.ValidatorGovInfo{
+				valAddr: govv1.NewValidatorGovInfo(sdk.ValAddress(validator), math.NewInt(200), math.LegacyNewDec(200), math.LegacyZeroDec(), nil),
+			}
+
+			votes := map[string]govv1.VoteOption{
+				alice.String():     govv1.OptionYes,
+				bob.String():       govv1.OptionNo,
+				carol.String():     govv1.OptionYes,
+				validator.String(): govv1.OptionNo,
+			}
+			for voter, option := range votes {
+				address := sdk.MustAccAddressFromBech32(voter)
+				vote := govv1.NewVote(proposalID, address, govv1.NewNonSplitVoteOption(option), "")
+				require.NoError(t, f.govKeeper.Votes.Set(f.ctx, collections.Join(uint64(proposalID), address), vote))
+			}
+
+			total, results, err := f.keeper.Tally(f.ctx, f.govKeeper.Votes, proposalID, validators)
+			require.NoError(t, err)
+			require.Equal(t, tc.total, total.String())
+			for option, expected := range tc.expected {
+				require.Equal(t, expected, results[option].String(), option.String())
+			}
+			require.True(t, results[govv1.OptionAbstain].IsZero())
+
+			// The tallied votes are removed
+			iter, err := f.govKeeper.Votes.Iterate(f.ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID))
+			require.NoError(t, err)
+			defer iter.Close()
+			require.False(t, iter.Valid())
+		})
+	}
+}
+
+// TestTallyByStakeMatchesGov checks that the stake tally, a copy of the
+// default tally of x/gov, still tallies the votes the way x/gov does.
+func TestTallyByStakeMatchesGov(t *testing.T) {
+	t.Parallel()
+
+	const proposalID = 1
+
+	validatorA := sdk.AccAddress("validator_a_________")
+	validatorB := sdk.AccAddress("validator_b_________")
+	validatorC := sdk.AccAddress("validator_c_________")
+	unbonded := sdk.AccAddress("validator_unbonded__")
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	carol := sdk.AccAddress("carol_______________")
+
+	split := govv1.WeightedVoteOptions{
+		govv1.NewWeightedVoteOption(govv1.OptionYes, math.LegacyNewDecWithPrec(6, 1)),
+		govv1.NewWeightedVoteOption(govv1.OptionNo, math.LegacyNewDecWithPrec(4, 1)),
+	}
+
+	tests := []struct {
+		name  string
+		votes map[string]govv1.WeightedVoteOptions
+	}{
+		{
+			name: "no votes",
+		},
+		{
+			name: "validators",
+			votes: map[string]govv1.WeightedVoteOptions{
+				validatorA.String(): govv1.NewNonSplitVoteOption(govv1.OptionYes),
+				validatorB.String(): govv1.NewNonSplitVoteOption(govv1.OptionNoWithVeto),
+			},
+		},
+		{
+			name: "delegators",
+			votes: map[string]govv1.WeightedVoteOptions{
+				alice.String(): split,
+				bob.String():   govv1.NewNonSplitVoteOption(govv1.OptionAbstain),
+				carol.String(): govv1.NewNonSplitVoteOption(govv1.OptionNo),
+			},
+		},
+		{
+			name: "validators and delegators",
+			votes: map[string]govv1.WeightedVoteOptions{
+				validatorA.String(): govv1.NewNonSplitVoteOption(govv1.OptionYes),
+				validatorB.String(): govv1.NewNonSplitVoteOption(govv1.OptionNoWithVeto),
+				alice.String():      split,
+				bob.String():        govv1.NewNonSplitVoteOption(govv1.OptionAbstain),
+				carol.String():      govv1.NewNonSplitVoteOption(govv1.OptionNo),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := setupFixture(t)
+			valAddr := func(address sdk.AccAddress) string {
+				valAddr, err := f.stakingKeeper.ValidatorAddressCodec().BytesToString(address)
+				require.NoError(t, err)
+				return valAddr
+			}
+			validator := func(address sdk.AccAddress, tokens, shares int64) stakingtypes.Validator {
+				return stakingtypes.Validator{
+					OperatorAddress: valAddr(address),
+					Status:          stakingtypes.Bonded,
+					Tokens:          math.NewInt(tokens),
+					DelegatorShares: math.LegacyNewDec(shares),
+				}
+			}
+
+			// Validator B was slashed, its shares are worth two tokens each.
+			// Carol's delegation is to a validator out of the bonded set.
+			f.stakingKeeper.totalBonded = math.NewInt(2000)
+			f.stakingKeeper.validators = []stakingtypes.Validator{
+				validator(validatorA, 1000, 1000),
+				validator(validatorB, 600, 300),
+				validator(validatorC, 400, 400),
+			}
+			f.stakingKeeper.delegations[string(alice)] = []stakingtypes.Delegation{
+				stakingtypes.NewDelegation(alice.String(), valAddr(validatorA), math.LegacyNewDec(200)),
+				stakingtypes.NewDelegation(alice.String(), valAddr(validatorB), math.LegacyNewDec(100)),
+			}
+			f.stakingKeeper.delegations[string(bob)] = []stakingtypes.Delegation{
+				stakingtypes.NewDelegation(bob.String(), valAddr(validatorB), math.LegacyNewDec(50)),
+				stakingtypes.NewDelegation(bob.String(), valAddr(validatorC), math.LegacyNewDec(100)),
+			}
+			f.stakingKeeper.delegations[string(carol)] = []stakingtypes.Delegation{
+				stakingtypes.NewDelegation(carol.String(), valAddr(unbonded), math.LegacyNewDec(500)),
+			}
+
+			tally := func(govKeeper *govkeeper.Keeper) (bool, bool, govv1.TallyResult) {
+				ctx, _ := f.ctx.CacheContext()
+				for voter, options := range tc.votes {
+					address := sdk.MustAccAddressFromBech32(voter)
+					vote := govv1.NewVote(proposalID, address, options, "")
+					require.NoError(t, govKeeper.Votes.Set(ctx, collections.Join(uint64(proposalID), address), vote))
+				}
+
+				passes, burnDeposits, results, err := govKeeper.Tally(ctx, govv1.Proposal{Id: proposalID})
+				require.NoError(t, err)
+				return passes, burnDeposits, results
+			}
+
+			storeService := runtime.NewKVStoreService(f.govKey)
+			custom := govkeeper.NewKeeper(f.codec, storeService, mockAccountKeeper{}, nil, f.stakingKeeper, nil, nil, govtypes.DefaultConfig(), f.authority,
+				govkeeper.WithCustomCalculateVoteResultsAndVotingPowerFn(f.keeper.CalculateVoteResultsAndVotingPower),
+			)
+
+			expPasses, expBurnDeposits, expResults := tally(f.govKeeper)
+			passes, burnDeposits, results := tally(custom)
+			require.Equal(t, expResults, results)
+			require.Equal(t, expPasses, passes)
+			require.Equal(t, expBurnDeposits, burnDeposits)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	"google.golang.org/grpc/codes"
+
+	"cosmossdk.io/errors"
+)
+
+// x/membership module sentinel errors.
+var (
+	ErrInvalidParams       = errors.Register(ModuleName, 2, "invalid membership params")
+	ErrInvalidMember       = errors.Register(ModuleName, 3, "invalid member")
+	ErrInvalidContribution = errors.Register(ModuleName, 4, "invalid contribution")
+	ErrNotMember           = errors.RegisterWithGRPCCode(ModuleName, 5, codes.NotFound, "not a member")
+)
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// AccountKeeper defines the expected account keeper.
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+}
+
+// BankKeeper defines the expected bank keeper.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
+
+// StakingKeeper defines the expected staking keeper, which the stake tally
+// reads the delegations of the voters from.
+type StakingKeeper interface {
+	BondDenom(ctx context.Context) (string, error)
+	ValidatorAddressCodec() address.Codec
+	TotalBondedTokens(ctx context.Context) (math.Int, error)
+	IterateDelegations(ctx context.Context, delegator sdk.AccAddress, fn func(index int64, delegation stakingtypes.DelegationI) (stop bool)) error
+}
+
+// VoteStore defines the expected store of governance votes, which is the x/gov
+// keeper's Votes map.
+type VoteStore interface {
+	Walk(ctx context.Context, ranger collections.Ranger[collections.Pair[uint64, sdk.AccAddress]], walkFunc func(key collections.Pair[uint64, sdk.AccAddress], vote govv1.Vote) (stop bool, err error)) error
+	Remove(ctx context.Context, key collections.Pair[uint64, sdk.AccAddress]) error
+}
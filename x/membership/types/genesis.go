@@ -0,0 +1,38 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, members []Member) *GenesisState {
+	return &GenesisState{
+		Params:  params,
+		Members: members,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state, which has no members.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// Validate performs a basic validation of the genesis state.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.validateGenesis(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.Members))
+	for _, member := range gs.Members {
+		if err := member.Validate(); err != nil {
+			return err
+		}
+		if seen[member.Address] {
+			return errors.Wrapf(ErrInvalidMember, "duplicate member %s", member.Address)
+		}
+		seen[member.Address] = true
+	}
+
+	return nil
+}
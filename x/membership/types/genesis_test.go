@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/unicorn-research/chain/x/membership/types"
+
+	"cosmossdk.io/math"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	t.Parallel()
+
+	member := func(address string, contribution int64) types.Member {
+		return types.Member{Address: address, Contribution: math.NewInt(contribution), JoinedHeight: 1}
+	}
+
+	tests := []struct {
+		name     string
+		genState *types.GenesisState
+		expErr   error
+	}{
+		{
+			name:     "default",
+			genState: types.DefaultGenesisState(),
+		},
+		{
+			name:     "members",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.Member{member("cosmos1a", 100), member("cosmos1b", 1)}),
+		},
+		{
+			name:     "contribution denom",
+			genState: types.NewGenesisState(types.NewParams("uatom", types.InterestModePerMember, true), nil),
+		},
+		{
+			name:     "invalid contribution denom",
+			genState: types.NewGenesisState(types.NewParams("1stake", types.InterestModeContribution, false), nil),
+			expErr:   types.ErrInvalidParams,
+		},
+		{
+			name:     "unspecified interest mode",
+			genState: types.NewGenesisState(types.NewParams("stake", types.InterestModeUnspecified, false), nil),
+			expErr:   types.ErrInvalidParams,
+		},
+		{
+			name:     "member without contribution",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.Member{member("cosmos1a", 0)}),
+			expErr:   types.ErrInvalidMember,
+		},
+		{
+			name:     "member without address",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.Member{member("", 100)}),
+			expErr:   types.ErrInvalidMember,
+		},
+		{
+			name:     "duplicate member",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.Member{member("cosmos1a", 100), member("cosmos1a", 1)}),
+			expErr:   types.ErrInvalidMember,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.genState.Validate()
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name, which is also the name of the DAO
+	// treasury module account.
+	ModuleName = "membership"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the key of the module parameters.
+	ParamsKey = collections.NewPrefix(0)
+	// MembersKey is the prefix of the members, keyed by address.
+	MembersKey = collections.NewPrefix(1)
+	// TotalContributionsKey is the key of the total contributions.
+	TotalContributionsKey = collections.NewPrefix(2)
+	// MemberCountKey is the key of the number of members.
+	MemberCountKey = collections.NewPrefix(3)
+)
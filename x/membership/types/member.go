@@ -0,0 +1,62 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// ContributionInterest returns the membership interest of a contribution
+// under W.S. 17-31-111(a)(i): the contribution divided by the total
+// contributions.
+func ContributionInterest(contribution, total math.Int) math.LegacyDec {
+	if !total.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+
+	return math.LegacyNewDecFromInt(contribution).QuoInt(total)
+}
+
+// PerMemberInterest returns the membership interest of a member under W.S.
+// 17-31-111(a)(ii): one membership interest out of the number of members.
+func PerMemberInterest(members uint64) math.LegacyDec {
+	if members == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	return math.LegacyOneDec().QuoInt(math.NewIntFromUint64(members))
+}
+
+// NewMemberInterest returns the membership interest of a member in both
+// calculation modes.
+func NewMemberInterest(member Member, totalContributions math.Int, members uint64) MemberInterest {
+	return MemberInterest{
+		Member:               member,
+		ContributionInterest: ContributionInterest(member.Contribution, totalContributions),
+		PerMemberInterest:    PerMemberInterest(members),
+	}
+}
+
+// Interest returns the membership interest in the calculation mode of the
+// params.
+func (mi MemberInterest) Interest(params Params) math.LegacyDec {
+	if params.InterestMode == InterestModePerMember {
+		return mi.PerMemberInterest
+	}
+
+	return mi.ContributionInterest
+}
+
+// Validate performs a basic validation of the member.
+func (m Member) Validate() error {
+	if m.Address == "" {
+		return errors.Wrap(ErrInvalidMember, "empty address")
+	}
+	if m.Contribution.IsNil() || !m.Contribution.IsPositive() {
+		return errors.Wrapf(ErrInvalidMember, "member %s has no contribution", m.Address)
+	}
+	if m.JoinedHeight < 0 {
+		return errors.Wrapf(ErrInvalidMember, "member %s joined at negative height %d", m.Address, m.JoinedHeight)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/unicorn-research/chain/x/membership/types"
+
+	"cosmossdk.io/math"
+)
+
+func TestMemberInterest(t *testing.T) {
+	t.Parallel()
+
+	member := types.Member{Address: "cosmos1member", Contribution: math.NewInt(300)}
+
+	interest := types.NewMemberInterest(member, math.NewInt(400), 4)
+	require.Equal(t, "0.750000000000000000", interest.ContributionInterest.String())
+	require.Equal(t, "0.250000000000000000", interest.PerMemberInterest.String())
+	require.Equal(t, interest.ContributionInterest, interest.Interest(types.NewParams("stake", types.InterestModeContribution, true)))
+	require.Equal(t, interest.PerMemberInterest, interest.Interest(types.NewParams("stake", types.InterestModePerMember, true)))
+
+	// Without contributions or members there is no interest
+	require.True(t, types.ContributionInterest(math.ZeroInt(), math.ZeroInt()).IsZero())
+	require.True(t, types.PerMemberInterest(0).IsZero())
+}
@@ -0,0 +1,1381 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: unicorn/membership/v1/membership.proto
+
+package types
+
+import (
+	_ "cosmossdk.io/api/amino"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InterestMode is how membership interests are calculated under W.S.
+// 17-31-111(a).
+type InterestMode int32
+
+const (
+	// INTEREST_MODE_UNSPECIFIED is an invalid interest mode.
+	InterestModeUnspecified InterestMode = 0
+	// INTEREST_MODE_CONTRIBUTION divides a member's contribution by the total
+	// contributions, under W.S. 17-31-111(a)(i).
+	InterestModeContribution InterestMode = 1
+	// INTEREST_MODE_PER_MEMBER gives each member one membership interest and one
+	// vote, under W.S. 17-31-111(a)(ii).
+	InterestModePerMember InterestMode = 2
+)
+
+var InterestMode_name = map[int32]string{
+	0: "INTEREST_MODE_UNSPECIFIED",
+	1: "INTEREST_MODE_CONTRIBUTION",
+	2: "INTEREST_MODE_PER_MEMBER",
+}
+
+var InterestMode_value = map[string]int32{
+	"INTEREST_MODE_UNSPECIFIED":  0,
+	"INTEREST_MODE_CONTRIBUTION": 1,
+	"INTEREST_MODE_PER_MEMBER":   2,
+}
+
+func (x InterestMode) String() string {
+	return proto.EnumName(InterestMode_name, int32(x))
+}
+
+func (InterestMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4a719094338c8784, []int{0}
+}
+
+// Params defines the parameters of the membership module.
+type Params struct {
+	// contribution_denom is the denom of the digital assets members contribute.
+	// Left empty in genesis, it is set to the bond denom.
+	ContributionDenom string `protobuf:"bytes,1,opt,name=contribution_denom,json=contributionDenom,proto3" json:"contribution_denom,omitempty"`
+	// interest_mode is how the membership interests are calculated, as the
+	// articles of organization provide.
+	InterestMode InterestMode `protobuf:"varint,2,opt,name=interest_mode,json=interestMode,proto3,enum=unicorn.membership.v1.InterestMode" json:"interest_mode,omitempty"`
+	// tally_by_membership tallies governance proposals by membership interest
+	// instead of stake.
+	TallyByMembership bool `protobuf:"varint,3,opt,name=tally_by_membership,json=tallyByMembership,proto3" json:"tally_by_membership,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a719094338c8784, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetContributionDenom() string {
+	if m != nil {
+		return m.ContributionDenom
+	}
+	return ""
+}
+
+func (m *Params) GetInterestMode() InterestMode {
+	if m != nil {
+		return m.InterestMode
+	}
+	return InterestModeUnspecified
+}
+
+func (m *Params) GetTallyByMembership() bool {
+	if m != nil {
+		return m.TallyByMembership
+	}
+	return false
+}
+
+// Member is a member of the DAO, who contributed digital assets to the
+// treasury.
+type Member struct {
+	// address is the address of the member.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// contribution is the total amount the member contributed.
+	Contribution cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=contribution,proto3,customtype=cosmossdk.io/math.Int" json:"contribution"`
+	// joined_height is the block height of the member's first contribution.
+	JoinedHeight int64 `protobuf:"varint,3,opt,name=joined_height,json=joinedHeight,proto3" json:"joined_height,omitempty"`
+	// joined_time is the block time of the member's first contribution.
+	JoinedTime time.Time `protobuf:"bytes,4,opt,name=joined_time,json=joinedTime,proto3,stdtime" json:"joined_time"`
+}
+
+func (m *Member) Reset()         { *m = Member{} }
+func (m *Member) String() string { return proto.CompactTextString(m) }
+func (*Member) ProtoMessage()    {}
+func (*Member) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a719094338c8784, []int{1}
+}
+func (m *Member) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Member) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Member.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Member) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Member.Merge(m, src)
+}
+func (m *Member) XXX_Size() int {
+	return m.Size()
+}
+func (m *Member) XXX_DiscardUnknown() {
+	xxx_messageInfo_Member.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Member proto.InternalMessageInfo
+
+func (m *Member) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Member) GetJoinedHeight() int64 {
+	if m != nil {
+		return m.JoinedHeight
+	}
+	return 0
+}
+
+func (m *Member) GetJoinedTime() time.Time {
+	if m != nil {
+		return m.JoinedTime
+	}
+	return time.Time{}
+}
+
+// MemberInterest is the membership interest of a member in both calculation
+// modes.
+type MemberInterest struct {
+	// member is the member.
+	Member Member `protobuf:"bytes,1,opt,name=member,proto3" json:"member"`
+	// contribution_interest is the member's contribution divided by the total
+	// contributions.
+	ContributionInterest cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=contribution_interest,json=contributionInterest,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"contribution_interest"`
+	// per_member_interest is the member's one membership interest divided by
+	// the number of members.
+	PerMemberInterest cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=per_member_interest,json=perMemberInterest,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"per_member_interest"`
+}
+
+func (m *MemberInterest) Reset()         { *m = MemberInterest{} }
+func (m *MemberInterest) String() string { return proto.CompactTextString(m) }
+func (*MemberInterest) ProtoMessage()    {}
+func (*MemberInterest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a719094338c8784, []int{2}
+}
+func (m *MemberInterest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberInterest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemberInterest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemberInterest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberInterest.Merge(m, src)
+}
+func (m *MemberInterest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberInterest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberInterest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberInterest proto.InternalMessageInfo
+
+func (m *MemberInterest) GetMember() Member {
+	if m != nil {
+		return m.Member
+	}
+	return Member{}
+}
+
+// EventContribution is emitted when a member contributes to the treasury.
+type EventContribution struct {
+	// member is the address of the member.
+	Member string `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	// amount is the contributed amount.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// contribution is the member's total contribution.
+	Contribution cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=contribution,proto3,customtype=cosmossdk.io/math.Int" json:"contribution"`
+	// new_member is whether the contribution made the contributor a member.
+	NewMember bool `protobuf:"varint,4,opt,name=new_member,json=newMember,proto3" json:"new_member,omitempty"`
+}
+
+func (m *EventContribution) Reset()         { *m = EventContribution{} }
+func (m *EventContribution) String() string { return proto.CompactTextString(m) }
+func (*EventContribution) ProtoMessage()    {}
+func (*EventContribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a719094338c8784, []int{3}
+}
+func (m *EventContribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventContribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventContribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContribution.Merge(m, src)
+}
+func (m *EventContribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventContribution proto.InternalMessageInfo
+
+func (m *EventContribution) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *EventContribution) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *EventContribution) GetNewMember() bool {
+	if m != nil {
+		return m.NewMember
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("unicorn.membership.v1.InterestMode", InterestMode_name, InterestMode_value)
+	proto.RegisterType((*Params)(nil), "unicorn.membership.v1.Params")
+	proto.RegisterType((*Member)(nil), "unicorn.membership.v1.Member")
+	proto.RegisterType((*MemberInterest)(nil), "unicorn.membership.v1.MemberInterest")
+	proto.RegisterType((*EventContribution)(nil), "unicorn.membership.v1.EventContribution")
+}
+
+func init() {
+	proto.RegisterFile("unicorn/membership/v1/membership.proto", fileDescriptor_4a719094338c8784)
+}
+
+var fileDescriptor_4a719094338c8784 = []byte{
+	// 772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0xc7, 0x45, 0x29, 0x50, 0xa3, 0x93, 0x1c, 0x58, 0x8c, 0x85, 0x4a, 0x74, 0x43, 0x11, 0x0a,
+	0x50, 0x08, 0x06, 0x44, 0x46, 0x6a, 0xd1, 0x02, 0x41, 0x86, 0x96, 0x12, 0x8b, 0xa8, 0xa8, 0x64,
+	0x81, 0x96, 0x97, 0x2e, 0x04, 0x7f, 0x9c, 0xa9, 0x6b, 0xcc, 0x3b, 0x81, 0x3c, 0x29, 0xd5, 0xdc,
+	0xa5, 0xd0, 0x94, 0x7f, 0xc0, 0x53, 0x87, 0x76, 0xcc, 0x90, 0xff, 0xa1, 0x19, 0x83, 0x74, 0x29,
+	0x3a, 0xb8, 0x85, 0x3d, 0xf8, 0x5f, 0xe8, 0x58, 0x90, 0x77, 0xb4, 0xc9, 0xba, 0xed, 0xd2, 0x2c,
+	0x82, 0xee, 0xbe, 0xef, 0xfb, 0xee, 0xde, 0xe7, 0xbd, 0x23, 0xf8, 0x70, 0x85, 0x91, 0x4b, 0x42,
+	0xac, 0x05, 0x30, 0x70, 0x60, 0x18, 0x2d, 0xd0, 0x52, 0x5b, 0xf7, 0x33, 0x2b, 0x75, 0x19, 0x12,
+	0x4a, 0xc4, 0x06, 0x8f, 0x53, 0x33, 0xca, 0xba, 0x2f, 0xd5, 0xed, 0x00, 0x61, 0xa2, 0x25, 0xbf,
+	0x2c, 0x52, 0x92, 0x5d, 0x12, 0x05, 0x24, 0xd2, 0x1c, 0x3b, 0x82, 0xda, 0xba, 0xef, 0x40, 0x6a,
+	0xf7, 0x35, 0x97, 0x20, 0xcc, 0xf5, 0x16, 0xd3, 0xad, 0x64, 0xa5, 0xb1, 0x05, 0x97, 0xf6, 0x7c,
+	0xe2, 0x13, 0xb6, 0x1f, 0xff, 0xe3, 0xbb, 0x6d, 0x9f, 0x10, 0xff, 0x14, 0x6a, 0xc9, 0xca, 0x59,
+	0x9d, 0x68, 0x14, 0x05, 0x30, 0xa2, 0x76, 0xc0, 0xef, 0xd6, 0xf9, 0x45, 0x00, 0xe5, 0x99, 0x1d,
+	0xda, 0x41, 0x24, 0xf6, 0x80, 0xe8, 0x12, 0x4c, 0x43, 0xe4, 0xac, 0x28, 0x22, 0xd8, 0xf2, 0x20,
+	0x26, 0x41, 0x53, 0x50, 0x84, 0x6e, 0xc5, 0xac, 0x67, 0x95, 0x51, 0x2c, 0x88, 0x4f, 0xc1, 0x0e,
+	0xc2, 0x14, 0x86, 0x30, 0xa2, 0x56, 0x40, 0x3c, 0xd8, 0x2c, 0x2a, 0x42, 0xf7, 0xde, 0xe0, 0xa1,
+	0xfa, 0x8f, 0xd5, 0xaa, 0x63, 0x1e, 0x3b, 0x21, 0x1e, 0x34, 0x6b, 0x28, 0xb3, 0x12, 0x55, 0x70,
+	0x9f, 0xda, 0xa7, 0xa7, 0x1b, 0xcb, 0xd9, 0x58, 0x37, 0xa6, 0x66, 0x49, 0x11, 0xba, 0x77, 0xcd,
+	0x7a, 0x22, 0xe9, 0x9b, 0xc9, 0xb5, 0xf0, 0x58, 0xd9, 0x5e, 0xbd, 0x3c, 0xd8, 0x4f, 0xe1, 0x7f,
+	0x9b, 0xc5, 0xcf, 0x4a, 0xe9, 0x7c, 0x57, 0x04, 0x65, 0x66, 0x10, 0x07, 0xe0, 0x3d, 0xdb, 0xf3,
+	0x42, 0x18, 0x45, 0xac, 0x14, 0xbd, 0xf9, 0xf6, 0x55, 0x6f, 0x8f, 0xa3, 0xfb, 0x9c, 0x29, 0x47,
+	0x34, 0x44, 0xd8, 0x37, 0xd3, 0x40, 0x71, 0x0e, 0x6a, 0xd9, 0x7a, 0x93, 0xca, 0x2a, 0xfa, 0xa3,
+	0xd7, 0xe7, 0xed, 0xc2, 0x6f, 0xe7, 0xed, 0x06, 0x33, 0x47, 0xde, 0x33, 0x15, 0x11, 0x2d, 0xb0,
+	0xe9, 0x22, 0x2e, 0xee, 0xed, 0xab, 0x1e, 0xe0, 0x59, 0xc7, 0x98, 0xfe, 0x74, 0xf5, 0xf2, 0x40,
+	0x30, 0x73, 0x59, 0xc4, 0x87, 0x60, 0xe7, 0x1b, 0x82, 0x30, 0xf4, 0xac, 0x05, 0x44, 0xfe, 0x82,
+	0x26, 0x05, 0x96, 0xcc, 0x1a, 0xdb, 0x7c, 0x9a, 0xec, 0x89, 0x5f, 0x82, 0x2a, 0x0f, 0x8a, 0x3b,
+	0xd5, 0xbc, 0xa3, 0x08, 0xdd, 0xea, 0x40, 0x52, 0x59, 0x1b, 0xd5, 0xb4, 0x8d, 0xea, 0x3c, 0x6d,
+	0xa3, 0xbe, 0x13, 0xdf, 0xea, 0xc5, 0xef, 0x6d, 0x81, 0x1d, 0x09, 0x98, 0x3b, 0xd6, 0x3b, 0x3f,
+	0x16, 0xc1, 0x3d, 0x46, 0x21, 0x85, 0x2f, 0x7e, 0x06, 0xca, 0x8c, 0x56, 0x02, 0xa3, 0x3a, 0x78,
+	0xf0, 0x2f, 0xdd, 0x62, 0x36, 0xbd, 0x12, 0x27, 0x67, 0x89, 0xb9, 0x4f, 0x7c, 0x06, 0x1a, 0xb9,
+	0x29, 0x49, 0x3b, 0xc9, 0x21, 0x7d, 0xc2, 0x21, 0xed, 0xdf, 0x86, 0xf4, 0x15, 0xf4, 0x6d, 0x77,
+	0x33, 0x82, 0x6e, 0x06, 0xd5, 0x08, 0xba, 0x2c, 0xfd, 0x5e, 0x36, 0xe9, 0xf5, 0x75, 0x4f, 0xc0,
+	0xfd, 0x25, 0x0c, 0xf9, 0x50, 0xdc, 0x1c, 0x55, 0xfa, 0x5f, 0x47, 0xd5, 0x97, 0x30, 0xcc, 0x63,
+	0xe9, 0xfc, 0x29, 0x80, 0xba, 0xb1, 0x86, 0x98, 0x0e, 0xb3, 0x0d, 0x7b, 0x94, 0x83, 0xf5, 0x5f,
+	0x93, 0x93, 0xc2, 0x79, 0x02, 0xca, 0x76, 0x40, 0x56, 0x98, 0xd1, 0xa8, 0x0e, 0x5a, 0x2a, 0x0f,
+	0x8f, 0x1f, 0xb4, 0xca, 0x1f, 0xb4, 0x3a, 0x24, 0x08, 0xe7, 0xd0, 0x32, 0xcf, 0xad, 0xb1, 0x2b,
+	0xbd, 0x93, 0xb1, 0x7b, 0x00, 0x00, 0x86, 0xcf, 0x39, 0xc3, 0x64, 0xa0, 0xee, 0x9a, 0x15, 0x0c,
+	0x9f, 0x33, 0x04, 0x07, 0x3f, 0x0b, 0xa0, 0x96, 0x7d, 0x9b, 0xe2, 0x63, 0xd0, 0x1a, 0x4f, 0xe7,
+	0x86, 0x69, 0x1c, 0xcd, 0xad, 0xc9, 0xe1, 0xc8, 0xb0, 0x8e, 0xa7, 0x47, 0x33, 0x63, 0x38, 0xfe,
+	0x62, 0x6c, 0x8c, 0x76, 0x0b, 0xd2, 0xfe, 0xf6, 0x4c, 0x79, 0x3f, 0x6b, 0x38, 0xc6, 0xd1, 0x12,
+	0xba, 0xe8, 0x04, 0x41, 0x4f, 0x7c, 0x02, 0xa4, 0xbc, 0x77, 0x78, 0x38, 0x9d, 0x9b, 0x63, 0xfd,
+	0x78, 0x3e, 0x3e, 0x9c, 0xee, 0x0a, 0xd2, 0x07, 0xdb, 0x33, 0xa5, 0x99, 0x35, 0xe7, 0x78, 0x7f,
+	0x0a, 0x9a, 0x79, 0xf7, 0xcc, 0x30, 0xad, 0x89, 0x31, 0xd1, 0x0d, 0x73, 0xb7, 0x28, 0xb5, 0xb6,
+	0x67, 0x4a, 0x23, 0xeb, 0x9d, 0xa5, 0x6d, 0x94, 0xee, 0x7c, 0xff, 0x83, 0x5c, 0xd0, 0xa7, 0xaf,
+	0x2f, 0x64, 0xe1, 0xcd, 0x85, 0x2c, 0xfc, 0x71, 0x21, 0x0b, 0x2f, 0x2e, 0xe5, 0xc2, 0x9b, 0x4b,
+	0xb9, 0xf0, 0xeb, 0xa5, 0x5c, 0xf8, 0xfa, 0x63, 0x1f, 0xd1, 0xc5, 0xca, 0x51, 0x5d, 0x12, 0x68,
+	0x7c, 0xde, 0x7b, 0x21, 0x8c, 0xa0, 0x1d, 0xba, 0x0b, 0xcd, 0x5d, 0xd8, 0xe8, 0x6f, 0x5f, 0x11,
+	0xba, 0x59, 0xc2, 0xc8, 0x29, 0x27, 0xaf, 0xed, 0xa3, 0xbf, 0x06, 0x00, 0x72, 0x1b, 0xae, 0x11,
+	0xe7, 0x05, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TallyByMembership {
+		i--
+		if m.TallyByMembership {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InterestMode != 0 {
+		i = encodeVarintMembership(dAtA, i, uint64(m.InterestMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContributionDenom) > 0 {
+		i -= len(m.ContributionDenom)
+		copy(dAtA[i:], m.ContributionDenom)
+		i = encodeVarintMembership(dAtA, i, uint64(len(m.ContributionDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Member) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Member) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JoinedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JoinedTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMembership(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.JoinedHeight != 0 {
+		i = encodeVarintMembership(dAtA, i, uint64(m.JoinedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Contribution.Size()
+		i -= size
+		if _, err := m.Contribution.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMembership(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMembership(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MemberInterest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberInterest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberInterest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PerMemberInterest.Size()
+		i -= size
+		if _, err := m.PerMemberInterest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMembership(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ContributionInterest.Size()
+		i -= size
+		if _, err := m.ContributionInterest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMembership(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMembership(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewMember {
+		i--
+		if m.NewMember {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Contribution.Size()
+		i -= size
+		if _, err := m.Contribution.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMembership(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMembership(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintMembership(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMembership(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMembership(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContributionDenom)
+	if l > 0 {
+		n += 1 + l + sovMembership(uint64(l))
+	}
+	if m.InterestMode != 0 {
+		n += 1 + sovMembership(uint64(m.InterestMode))
+	}
+	if m.TallyByMembership {
+		n += 2
+	}
+	return n
+}
+
+func (m *Member) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMembership(uint64(l))
+	}
+	l = m.Contribution.Size()
+	n += 1 + l + sovMembership(uint64(l))
+	if m.JoinedHeight != 0 {
+		n += 1 + sovMembership(uint64(m.JoinedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JoinedTime)
+	n += 1 + l + sovMembership(uint64(l))
+	return n
+}
+
+func (m *MemberInterest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Member.Size()
+	n += 1 + l + sovMembership(uint64(l))
+	l = m.ContributionInterest.Size()
+	n += 1 + l + sovMembership(uint64(l))
+	l = m.PerMemberInterest.Size()
+	n += 1 + l + sovMembership(uint64(l))
+	return n
+}
+
+func (m *EventContribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovMembership(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMembership(uint64(l))
+	l = m.Contribution.Size()
+	n += 1 + l + sovMembership(uint64(l))
+	if m.NewMember {
+		n += 2
+	}
+	return n
+}
+
+func sovMembership(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMembership(x uint64) (n int) {
+	return sovMembership(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMembership
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContributionDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMembership
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMembership
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMembership
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContributionDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestMode", wireType)
+			}
+			m.InterestMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMembership
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InterestMode |= InterestMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyByMembership", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMembership
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TallyByMembership = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMembership(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMembership
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Member) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMembership
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMembership
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMembership
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMembership
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contribution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMembership
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMembership
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMembership
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Contribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinedHeight", wireType)
+			}
+			m.JoinedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMembership
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMembership
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMembership
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMembership
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JoinedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMembership(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMembership
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberInterest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMembership
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberInterest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberInterest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMembership
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMembership
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMembership
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContributionInterest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMembership
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMembership
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMembership
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContributionInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerMemberInterest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMembership
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMembership
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMembership
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerMemberInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMembership(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMembership
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventContribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMembership
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventContribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventContribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMembership
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMembership
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMembership
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMembership
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMembership
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMembership
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contribution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMembership
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMembership
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMembership
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Contribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewMember", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMembership
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NewMember = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMembership(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMembership
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMembership(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMembership
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMembership
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMembership
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMembership
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMembership
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMembership
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMembership        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMembership          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMembership = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgContribute{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgContribute creates a new MsgContribute instance.
+func NewMsgContribute(member string, amount sdk.Coin) *MsgContribute {
+	return &MsgContribute{
+		Member: member,
+		Amount: amount,
+	}
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
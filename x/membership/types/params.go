@@ -0,0 +1,53 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams creates a new Params instance.
+func NewParams(contributionDenom string, interestMode InterestMode, tallyByMembership bool) Params {
+	return Params{
+		ContributionDenom: contributionDenom,
+		InterestMode:      interestMode,
+		TallyByMembership: tallyByMembership,
+	}
+}
+
+// DefaultParams returns the default membership parameters, which calculate
+// the interests from the contributions and keep tallying proposals by stake.
+// The contribution denom is left empty for InitGenesis to set to the chain's
+// bond denom.
+func DefaultParams() Params {
+	return NewParams("", InterestModeContribution, false)
+}
+
+// Validate performs a basic validation of the parameters.
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.ContributionDenom); err != nil {
+		return errors.Wrapf(ErrInvalidParams, "invalid contribution denom: %s", err)
+	}
+
+	return p.validateInterestMode()
+}
+
+// validateGenesis validates the parameters of a genesis state, where the
+// contribution denom may be left empty for the bond denom.
+func (p Params) validateGenesis() error {
+	if p.ContributionDenom == "" {
+		return p.validateInterestMode()
+	}
+
+	return p.Validate()
+}
+
+func (p Params) validateInterestMode() error {
+	switch p.InterestMode {
+	case InterestModeContribution, InterestModePerMember:
+	default:
+		return errors.Wrapf(ErrInvalidParams, "invalid interest mode %s", p.InterestMode)
+	}
+
+	return nil
+}